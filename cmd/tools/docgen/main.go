@@ -0,0 +1,443 @@
+// Command docgen generates the descriptions and allowed values of the types used for
+// the data of the resources, which are not available using reflection. They are read
+// from the comments and constants in the source of the types, and from the API
+// specification of the SDK for the types whose source has no comments.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/applications"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/util/schema"
+)
+
+const (
+	sdkModule = "github.com/ibm-verify/verify-sdk-go"
+
+	// specFile is the path of the API specification in the SDK module.
+	specFile = "openapi/openapi_latest.json"
+)
+
+// specSchemas are the schemas of the API specification that describe the types
+// whose source has no comments. The first schema that describes a property is used,
+// and the comments in the source take precedence.
+var specSchemas = map[reflect.Type][]string{
+	reflect.TypeOf(applications.Application{}):            {"ApplicationRequestBean", "AdminApplicationWithoutProv"},
+	reflect.TypeOf(applications.AdaptiveAuthentication{}): {"AdaptiveAuthenticationBean"},
+	reflect.TypeOf(applications.APIAccessClient{}):        {"APIAccessClientBean"},
+	reflect.TypeOf(applications.AttributeMapping{}):       {"ProvisioningAttrMappingsBean", "AttributeMapBean"},
+	reflect.TypeOf(applications.Bookmark{}):               {"BookmarkBean"},
+	reflect.TypeOf(applications.Customization{}):          {"CustomizationBean"},
+	reflect.TypeOf(applications.DevportalSettings{}):      {"DevportalSettingsBean"},
+	reflect.TypeOf(applications.GrantTypes{}):             {"GrantTypesBean"},
+	reflect.TypeOf(applications.JWTBearerProperties{}):    {"OIDCJwtBearerPropertiesBean"},
+	reflect.TypeOf(applications.ManageNameIDService{}):    {"ManageNameIDService"},
+	reflect.TypeOf(applications.OIDC{}):                   {"OIDCBean"},
+	reflect.TypeOf(applications.OIDCProperties{}):         {"OIDCPropertiesBean"},
+	reflect.TypeOf(applications.SAML{}):                   {"SAMLBean"},
+	reflect.TypeOf(applications.SAMLProperties{}):         {"SAMLPropertiesBean"},
+	reflect.TypeOf(applications.SSO{}):                    {"SSOBean"},
+	reflect.TypeOf(applications.Token{}):                  {"OIDCTokenBean"},
+	reflect.TypeOf(applications.WSFedProperties{}):        {"WsFedPropertiesBean"},
+}
+
+// specSchema is the subset of a schema of the API specification that is read.
+type specSchema struct {
+	Description string                 `json:"description"`
+	Properties  map[string]*specSchema `json:"properties"`
+	Items       *specSchema            `json:"items"`
+	Enum        []interface{}          `json:"enum"`
+}
+
+func main() {
+	output := flag.String("o", "", "Path to the generated file.")
+	flag.Parse()
+
+	if err := run(*output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(output string) error {
+	if len(output) == 0 {
+		return fmt.Errorf("the output file is required")
+	}
+
+	types := map[string]map[string]bool{}
+	collect(reflect.TypeOf(resource.Overlay{}), types, map[reflect.Type]bool{})
+	for _, h := range resource.KindHandlers() {
+		collect(h.DataType, types, map[reflect.Type]bool{})
+	}
+
+	dirs, err := packageDirs(types)
+	if err != nil {
+		return err
+	}
+
+	docs := map[string]*schema.TypeDoc{}
+	for pkgPath, names := range types {
+		if err := readPackage(pkgPath, dirs[pkgPath], names, docs); err != nil {
+			return err
+		}
+	}
+
+	if err := readSpec(docs); err != nil {
+		return err
+	}
+
+	b, err := generate(docs)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(output, b, 0o644)
+}
+
+// collect adds the named types that are reachable from the type, keyed by the path
+// of their package. Types of the standard library are skipped.
+func collect(t reflect.Type, types map[string]map[string]bool, seen map[reflect.Type]bool) {
+	if t == nil || seen[t] {
+		return
+	}
+
+	seen[t] = true
+	if len(t.Name()) > 0 && strings.Contains(strings.Split(t.PkgPath(), "/")[0], ".") {
+		if types[t.PkgPath()] == nil {
+			types[t.PkgPath()] = map[string]bool{}
+		}
+
+		types[t.PkgPath()][t.Name()] = true
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		collect(t.Elem(), types, seen)
+	case reflect.Map:
+		collect(t.Elem(), types, seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			collect(t.Field(i).Type, types, seen)
+		}
+	}
+}
+
+// packageDirs returns the directories of the packages using 'go list', which also
+// finds the packages of dependencies and internal packages.
+func packageDirs(types map[string]map[string]bool) (map[string]string, error) {
+	args := []string{"list", "-f", "{{.ImportPath}} {{.Dir}}"}
+	for pkgPath := range types {
+		args = append(args, pkgPath)
+	}
+
+	cmd := exec.Command("go", args...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the packages; err=%v", err)
+	}
+
+	dirs := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if pkgPath, dir, ok := strings.Cut(line, " "); ok {
+			dirs[pkgPath] = dir
+		}
+	}
+
+	return dirs, nil
+}
+
+// readPackage adds the documentation of the types in the package.
+func readPackage(pkgPath string, dir string, names map[string]bool, docs map[string]*schema.TypeDoc) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	doc := func(name string) *schema.TypeDoc {
+		key := pkgPath + "." + name
+		if docs[key] == nil {
+			docs[key] = &schema.TypeDoc{}
+		}
+
+		return docs[key]
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+
+		generated := ast.IsGenerated(file)
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if !names[spec.Name.Name] || spec.Assign.IsValid() {
+						continue
+					}
+
+					comment := spec.Doc
+					if comment == nil && len(gen.Specs) == 1 {
+						comment = gen.Doc
+					}
+
+					d := doc(spec.Name.Name)
+					d.Description = cleanComment(comment, spec.Name.Name, generated)
+					if st, ok := spec.Type.(*ast.StructType); ok {
+						d.Fields = fieldDocs(st, generated)
+					}
+				case *ast.ValueSpec:
+					ident, ok := spec.Type.(*ast.Ident)
+					if gen.Tok != token.CONST || !ok || !names[ident.Name] {
+						continue
+					}
+
+					for _, v := range spec.Values {
+						if lit, ok := v.(*ast.BasicLit); ok {
+							value := lit.Value
+							if lit.Kind == token.STRING {
+								value, _ = strconv.Unquote(value)
+							}
+
+							d := doc(ident.Name)
+							d.Enum = append(d.Enum, value)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// readSpec adds the descriptions and allowed values of the properties of the schemas
+// in specSchemas to the documentation of their types, where the source has none.
+func readSpec(docs map[string]*schema.TypeDoc) error {
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModule)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("unable to find the module %s; err=%v", sdkModule, err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(strings.TrimSpace(string(out)), specFile))
+	if err != nil {
+		return err
+	}
+
+	spec := struct {
+		Components struct {
+			Schemas map[string]*specSchema `json:"schemas"`
+		} `json:"components"`
+	}{}
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return fmt.Errorf("unable to parse the API specification; err=%v", err)
+	}
+
+	for t, names := range specSchemas {
+		key := schema.TypeKey(t)
+		if docs[key] == nil {
+			docs[key] = &schema.TypeDoc{}
+		}
+
+		d := docs[key]
+		for _, name := range names {
+			s := spec.Components.Schemas[name]
+			if s == nil {
+				return fmt.Errorf("the schema %s of %s is not in the API specification", name, key)
+			}
+
+			if len(d.Description) == 0 {
+				d.Description = cleanSpecText(s.Description)
+			}
+
+			for i := 0; i < t.NumField(); i++ {
+				field := t.Field(i)
+				jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+				p := s.Properties[jsonName]
+				if p == nil {
+					continue
+				}
+
+				if text := cleanSpecText(p.Description); len(text) > 0 && len(d.Fields[jsonName]) == 0 {
+					if d.Fields == nil {
+						d.Fields = map[string]string{}
+					}
+
+					d.Fields[jsonName] = text
+				}
+
+				// the booleans are strings in the specification
+				if len(p.Enum) > 0 && field.Type.Kind() == reflect.String && len(d.FieldEnums[jsonName]) == 0 {
+					if d.FieldEnums == nil {
+						d.FieldEnums = map[string][]string{}
+					}
+
+					for _, v := range p.Enum {
+						d.FieldEnums[jsonName] = append(d.FieldEnums[jsonName], fmt.Sprint(v))
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// cleanSpecText returns the description of the specification as a single line,
+// without the quotes that some of them are wrapped in.
+func cleanSpecText(text string) string {
+	return strings.Trim(strings.Join(strings.Fields(text), " "), `"`)
+}
+
+// fieldDocs returns the comments of the fields keyed by their JSON names.
+func fieldDocs(st *ast.StructType, generated bool) map[string]string {
+	fields := map[string]string{}
+	for _, field := range st.Fields.List {
+		comment := field.Doc
+		if comment == nil {
+			comment = field.Comment
+		}
+
+		for _, ident := range field.Names {
+			name := ident.Name
+			if field.Tag != nil {
+				tag, _ := strconv.Unquote(field.Tag.Value)
+				if jsonName, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ","); len(jsonName) > 0 {
+					name = jsonName
+				}
+			}
+
+			if text := cleanComment(comment, ident.Name, generated); len(text) > 0 && name != "-" {
+				fields[name] = text
+			}
+		}
+	}
+
+	return fields
+}
+
+// cleanComment returns the comment as a single line. The comments of generated code
+// start with the name followed by the description from the specification, such as
+// 'Name the name of...', so the name is removed.
+func cleanComment(comment *ast.CommentGroup, name string, generated bool) string {
+	if comment == nil {
+		return ""
+	}
+
+	text := strings.Join(strings.Fields(comment.Text()), " ")
+	if !generated {
+		return text
+	}
+
+	// the generated models have no other description
+	if strings.HasPrefix(text, name+" defines model for ") {
+		return ""
+	}
+
+	text, _ = strings.CutPrefix(text, name+" ")
+	if r, size := utf8.DecodeRuneInString(text); size > 0 {
+		text = string(unicode.ToUpper(r)) + text[size:]
+	}
+
+	return text
+}
+
+func generate(docs map[string]*schema.TypeDoc) ([]byte, error) {
+	keys := make([]string, 0, len(docs))
+	for key, d := range docs {
+		if len(d.Description) > 0 || len(d.Fields) > 0 || len(d.Enum) > 0 || len(d.FieldEnums) > 0 {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	b := &bytes.Buffer{}
+	fmt.Fprintln(b, "// Code generated by docgen. DO NOT EDIT.")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "package resource")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, `import "github.com/ibm-verify/verifyctl/pkg/util/schema"`)
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "func init() {")
+	fmt.Fprintln(b, "typeDocs = map[string]*schema.TypeDoc{")
+	for _, key := range keys {
+		d := docs[key]
+		fmt.Fprintf(b, "%q: {\n", key)
+		if len(d.Description) > 0 {
+			fmt.Fprintf(b, "Description: %q,\n", d.Description)
+		}
+
+		if len(d.Fields) > 0 {
+			fmt.Fprintln(b, "Fields: map[string]string{")
+			names := make([]string, 0, len(d.Fields))
+			for name := range d.Fields {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Fprintf(b, "%q: %q,\n", name, d.Fields[name])
+			}
+
+			fmt.Fprintln(b, "},")
+		}
+
+		if len(d.Enum) > 0 {
+			fmt.Fprintf(b, "Enum: %#v,\n", d.Enum)
+		}
+
+		if len(d.FieldEnums) > 0 {
+			fmt.Fprintln(b, "FieldEnums: map[string][]string{")
+			names := make([]string, 0, len(d.FieldEnums))
+			for name := range d.FieldEnums {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Fprintf(b, "%q: %#v,\n", name, d.FieldEnums[name])
+			}
+
+			fmt.Fprintln(b, "},")
+		}
+
+		fmt.Fprintln(b, "},")
+	}
+
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b, "}")
+
+	return format.Source(b.Bytes())
+}
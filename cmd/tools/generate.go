@@ -0,0 +1,5 @@
+// Package tools holds the generators of the code derived from other sources. They
+// are run using 'make generate'.
+package tools
+
+//go:generate go run ./docgen -o ../../pkg/cmd/resource/docs_generated.go
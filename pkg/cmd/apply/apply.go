@@ -0,0 +1,155 @@
+package apply
+
+import (
+	"io"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	usage         = "apply -f=FILENAME [options]"
+	messagePrefix = "Apply"
+)
+
+var (
+	shortDesc = cmdutil.TranslateShortDesc(messagePrefix, "Create or update a Verify resource.")
+
+	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
+		Create or update Verify resources from a file or directory.
+
+The resource is looked up on the tenant using the 'UID' in the metadata or, if there is none or it
+does not exist, using its name. The name is read from the metadata or the data, such as the 'name' of
+an application or the 'userName' of a user. The resource is created if it does not exist and updated if
+it differs from the file. Only the properties in the file are compared, so properties managed by the
+tenant, such as IDs and timestamps, can be left out.
+
+JSON or YAML formats are accepted and determined based on the file extension. A file can hold several
+resources, either as YAML documents separated by '---' or as an 'IBMVerifyList'. If a directory is
+provided, the resources in all of its JSON and YAML files are applied. Use '-R' to read the
+subdirectories as well. Secrets can be references, such as 'env:NAME', and files can be rendered as
+templates using '--values' and '--set', as described in:
+
+  verifyctl create -h
+
+The result is reported as 'created', 'updated' or 'unchanged' for each resource.`))
+
+	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
+		# Create or update an application
+		verifyctl apply -f=./app-1098012.yaml
+
+		# Create or update the resources in a directory and its subdirectories
+		verifyctl apply -f=./resources -R
+
+		# Create or update the resources using the values for the production tenant
+		verifyctl apply -f=./resources --values=./values-prod.yaml`))
+)
+
+type options struct {
+	file      string
+	recursive bool
+
+	config *config.CLIConfig
+}
+
+func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &options{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   usage,
+		Short:                 shortDesc,
+		Long:                  longDesc,
+		Example:               examples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file or directory that contains the input data. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml. Use '-' to read from stdin."))
+	cmd.Flags().BoolVarP(&o.recursive, "recursive", "R", o.recursive, i18n.Translate("Read the files in the subdirectories as well when the input is a directory."))
+	resource.AddTemplateFlags(cmd)
+}
+
+func (o *options) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *options) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.file) == 0 {
+		return errorsx.G11NError("'file' option is required.")
+	}
+
+	return nil
+}
+
+func (o *options) Run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	vc := contextx.GetVerifyContext(ctx)
+
+	resourceObjects, err := resource.LoadObjects(cmd, o.file, o.recursive)
+	if err != nil {
+		vc.Logger.Errorf("unable to read the resources; err=%v", err)
+		return err
+	}
+
+	handlers := make([]*resource.KindHandler, 0, len(resourceObjects))
+	for _, resourceObject := range resourceObjects {
+		handler, err := resource.GetKindHandler(resourceObject.Kind)
+		if err != nil {
+			return errorsx.G11NError("Unable to apply the resource in '%s'; err=%v", resourceObject.Source(), err)
+		}
+
+		handlers = append(handlers, handler)
+	}
+
+	auth, err := o.config.SetAuthToContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// check all the resources before any of them are changed
+	for _, handler := range handlers {
+		if err := auth.CheckEntitlements(ctx, handler.Entitlements); err != nil {
+			return err
+		}
+	}
+
+	for i, resourceObject := range resourceObjects {
+		handler := handlers[i]
+		result, err := handler.Apply(ctx, resourceObject)
+		if err != nil {
+			if len(resourceObjects) > 1 {
+				return errorsx.G11NError("Unable to apply the '%s' resource in '%s'; err=%v", handler.Kind, resourceObject.Source(), err)
+			}
+
+			return err
+		}
+
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' %s", handler.Kind, handler.Name(resourceObject), result))
+	}
+
+	return nil
+}
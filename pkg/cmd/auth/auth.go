@@ -25,14 +25,31 @@ var (
 		
 First-time users of the client should run this command to connect to a tenant to establish an authorized session. 
 The issued OAuth 2.0 security token is saved to the configuration file at your home directory under ".verify/config".
+Use the '--credential-store' flag to save tokens and client secrets in the OS keyring or an encrypted file instead.
 
 There are two methods to generate the authorized token, based on flags:
 		
-  - As a user providing credentials
+  - As a user providing credentials, using the device flow or the authorization code flow in the browser
   - As an API client
 		
 In both cases, an OAuth token is generated with specific entitlements.
 
+The token is renewed automatically before it expires. User sessions are renewed using the refresh token,
+if one is issued, and API client sessions repeat the client credentials grant.
+
+Client secrets and private keys are only saved when the 'keyring' or 'file' credential store is used.
+With the default 'plain' store, the configuration file holds the tokens but not the client credentials,
+since they do not expire. This means that:
+
+  - API client sessions cannot be renewed, so you need to login again once the token expires.
+  - User sessions of applications that require client authentication cannot be renewed or revoked.
+    Login again once the token expires, and use 'verifyctl logout --force' to remove the session.
+
+Use '--credential-store=keyring' or '--credential-store=file' to renew sessions without logging in again.
+
+The 'client_secret' and 'key' in the auth resource file can be references, such as 'env:NAME',
+'file:/path' or 'exec:command args', so that the file can be committed without secrets.
+
 The auth resource file can be generated using:
 
   verifyctl auth --boilerplate`))
@@ -44,6 +61,29 @@ The auth resource file can be generated using:
 		# The connection created is permitted to perform actions based on the entitlements that
 		# are configured on the OAuth client and the entitlements of the user based on assigned groups and roles.
 		verifyctl auth -f=login.yaml
+
+		# Login as a user in the browser using the authorization code flow with PKCE. The auth
+		# resource file should include 'flow: authorization_code', and the application on Verify
+		# should allow the redirect URI 'http://127.0.0.1:<port>/callback'. Set 'redirect_port'
+		# in the file to use a fixed port.
+		verifyctl auth -f=browser-login.yaml
+
+		# Login and save the session as a named context. This allows multiple identities,
+		# such as an admin API client and a read-only user, to be used with the same tenant.
+		verifyctl auth -f=login.yaml --context=prod-readonly
+
+		# Run a command using a specific context.
+		verifyctl get users --context=prod-readonly
+
+		# Login and save the token in the OS keyring instead of the configuration file.
+		verifyctl auth -f=login.yaml --credential-store=keyring
+
+		# Login using a credential plugin. The auth resource file includes an 'exec' entry with
+		# the 'command', 'args' and 'env' to run. The command prints a JSON object with either
+		# 'token' and 'expiresAt', or 'clientId' and 'clientSecret'. The tenant is passed in the
+		# VERIFY_EXEC_TENANT environment variable. The token is cached in the credential store
+		# until it expires. It is not cached when the plain store is used.
+		verifyctl auth -f=vault-login.yaml
 	`))
 )
 
@@ -55,6 +95,7 @@ type options struct {
 	tenant       string
 	printOnly    bool
 	file         string
+	credStore    string
 
 	config *config.CLIConfig
 }
@@ -85,6 +126,10 @@ func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string)
 
 	o.AddFlags(cmd)
 
+	// add sub commands
+	cmd.AddCommand(newStatusCommand(config, streams, statusUsage))
+	cmd.AddCommand(newKeygenCommand(config, streams))
+
 	return cmd
 }
 
@@ -92,6 +137,7 @@ func (o *options) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.boilerplate, "boilerplate", o.boilerplate, i18n.TranslateWithArgs("Generate an empty %s file. This will be in YAML format.", "auth"))
 	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file parameters used to authenticate the request. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml."))
 	cmd.Flags().BoolVar(&o.printOnly, "print", false, i18n.Translate("Specify if the OAuth 2.0 access token should only be displayed and not persisted. Note that this means subsequent commands will not be able to make use of this token."))
+	cmd.Flags().StringVar(&o.credStore, "credential-store", o.credStore, i18n.Translate("Where tokens and client secrets are saved. The values supported are 'plain', 'keyring' and 'file'. The store is used for all sessions. Default: the store configured already or 'plain'."))
 	cmd.Flags().BoolVarP(&o.user, "user", "u", o.user, i18n.Translate("(Deprecated) Specify if a user login should be initiated."))
 	cmd.Flags().StringVar(&o.clientID, "clientId", o.clientID, i18n.Translate("(Deprecated) Client ID of the API client or application enabled the appropriate grant type."))
 	cmd.Flags().StringVar(&o.clientSecret, "clientSecret", o.clientSecret, i18n.Translate("(Deprecated) Client Secret of the API client or application enabled the appropriate grant type. This is optional if the application is configured as a public client."))
@@ -101,6 +147,8 @@ func (o *options) AddFlags(cmd *cobra.Command) {
 func (o *options) Complete(cmd *cobra.Command, args []string) error {
 	o.user = cmd.Flag("user").Changed
 	if len(args) == 0 {
+		// the tenant may be set using the global flag
+		o.tenant = o.config.TenantOverride
 		return nil
 	}
 
@@ -134,7 +182,7 @@ func (o *options) Run(cmd *cobra.Command, args []string) error {
 					"foo": []string{"bar"},
 				},
 				ClientAuthType: "private_key_jwt",
-				PrivateKeyRaw:  "<serialized_jwk> when auth_type is private_key_jwt",
+				PrivateKeyRaw:  "<serialized_jwk or PEM private key> or @<path> when auth_type is private_key_jwt",
 			},
 		}
 
@@ -142,7 +190,6 @@ func (o *options) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	token := ""
 	var authResource *AuthResource
 	var err error
 
@@ -171,34 +218,63 @@ func (o *options) Run(cmd *cobra.Command, args []string) error {
 		return errorsx.G11NError("'tenant' is required.")
 	}
 
-	if tokenResponse, err := o.authenticate(cmd, authResource); err != nil {
+	tokenResponse, err := o.authenticate(cmd, authResource)
+	if err != nil {
 		vc.Logger.Warn("authentication failed", "client", authResource.ClientID, "err", err)
 		return err
-	} else {
-		token = tokenResponse.AccessToken
 	}
 
 	if o.printOnly {
-		cmdutil.WriteString(cmd, token)
+		cmdutil.WriteString(cmd, tokenResponse.AccessToken)
 		return nil
 	}
 
-	// add token to config
-	if _, err := o.config.LoadFromFile(); err != nil {
+	clientConfig, err := authResource.ConvertToClientConfig()
+	if err != nil {
 		return err
 	}
 
-	o.config.AddAuth(&config.AuthConfig{
-		Tenant: authResource.Tenant,
-		Token:  token,
-		User:   authResource.User,
-	})
+	// add token to config
+	err = o.config.Update(func(c *config.CLIConfig) error {
+		if len(o.credStore) > 0 && o.credStore != c.CredentialStoreName() {
+			if err := c.SetCredentialStore(o.credStore); err != nil {
+				return err
+			}
+		}
+
+		// the context name defaults to the tenant
+		name := c.SelectedContext
+		if len(name) == 0 {
+			name = authResource.Tenant
+		}
+
+		authConfig := &config.AuthConfig{
+			Name:   name,
+			Tenant: authResource.Tenant,
+			User:   authResource.User,
+		}
+
+		// the token from a credential plugin is cached in the credential store
+		if authResource.Exec != nil {
+			authConfig.Exec = authResource.Exec
+		} else {
+			authConfig.Client = clientConfig
+			authConfig.SetToken(ctx, tokenResponse)
+		}
 
-	// set current tenant
-	o.config.SetCurrentTenant(authResource.Tenant)
+		c.AddAuth(authConfig)
+		c.AddContext(&config.ContextConfig{
+			Name:   name,
+			Tenant: authResource.Tenant,
+			Auth:   name,
+		})
+
+		// set current context and tenant
+		c.SetCurrentContext(name)
+		return nil
+	})
 
-	// persist contents
-	if _, err := o.config.PersistFile(); err != nil {
+	if err != nil {
 		return err
 	}
 
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"time"
+
+	oidc "github.com/ibm-verify/verify-sdk-go/pkg/auth"
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/spf13/cobra"
+)
+
+const (
+	callbackPath = "/callback"
+
+	// loginTimeout is how long to wait for the user to complete the login in the browser.
+	loginTimeout = 5 * time.Minute
+
+	callbackPage = `<html><body><p>%s</p></body></html>`
+)
+
+// authenticateWithBrowser runs the authorization code flow with PKCE. A listener is
+// started on the loopback interface to receive the authorization code.
+func (o *options) authenticateWithBrowser(ctx context.Context, cmd *cobra.Command, r *AuthResource, client *oidc.Client) (*oidc.TokenResponse, error) {
+	vc := contextx.GetVerifyContext(ctx)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", r.RedirectPort))
+	if err != nil {
+		return nil, errorsx.G11NError("Unable to start the listener for the redirect; err=%v", err)
+	}
+
+	defer listener.Close()
+
+	client.RedirectURL = fmt.Sprintf("http://%s%s", listener.Addr().String(), callbackPath)
+	authResponse, err := client.AuthorizeWithBrowserFlow(ctx, r.Parameters)
+	if err != nil {
+		vc.Logger.Errorf("Failed to initiate authorization code flow: err=%v", err)
+		return nil, err
+	}
+
+	callbackParams := make(chan url.Values, 1)
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != callbackPath {
+				http.NotFound(w, req)
+				return
+			}
+
+			message := i18n.Translate("Login complete. You can close this window and return to the terminal.")
+			if req.URL.Query().Get("error") != "" {
+				message = i18n.Translate("Login failed. Return to the terminal for details.")
+			}
+
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = fmt.Fprintf(w, callbackPage, message)
+
+			select {
+			case callbackParams <- req.URL.Query():
+			default:
+				// only the first callback is used
+			}
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			vc.Logger.Errorf("Redirect listener failed: err=%v", err)
+		}
+	}()
+
+	defer func() {
+		_ = server.Shutdown(context.Background())
+	}()
+
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("Login with %s", authResponse.AuthCodeURL))
+	if err := openBrowser(authResponse.AuthCodeURL); err != nil {
+		vc.Logger.Warnf("unable to open the browser; err=%v", err)
+	}
+
+	var params url.Values
+	select {
+	case params = <-callbackParams:
+	case <-time.After(loginTimeout):
+		return nil, errorsx.G11NError("Timed out waiting for the login to complete.")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	tokenResponse, err := client.TokenWithAuthCode(ctx, authResponse, params)
+	if err != nil {
+		vc.Logger.Errorf("Unable to get a token: err=%v", err)
+		return nil, err
+	}
+
+	return tokenResponse, nil
+}
+
+// openBrowser opens the URL using the default browser.
+func openBrowser(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+
+	return cmd.Start()
+}
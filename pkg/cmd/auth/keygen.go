@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/jwk"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	keygenUsage         = "keygen [flags]"
+	keygenMessagePrefix = "AuthKeygen"
+
+	publicFormatJWKS = "jwks"
+	publicFormatPEM  = "pem"
+
+	privateKeyPerm = 0o600
+	publicKeyPerm  = 0o644
+)
+
+var (
+	keygenLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(keygenMessagePrefix, `
+		Generate a key pair for the 'private_key_jwt' client authentication method.
+
+The private key is written as a JWK that can be referenced in the auth resource file using
+'key: "@<path>"'. The public key is written as a JWKS or in PEM format so that it can be
+registered on the API client or application. The key ID is the JWK thumbprint of the key.
+
+To rotate the client key, generate a new key pair, register the public key on the client
+alongside the existing one, login using the new key and then remove the old public key.`))
+
+	keygenExamples = templates.Examples(cmdutil.TranslateExamples(keygenMessagePrefix, `
+		# Generate an RSA key pair
+		verifyctl auth keygen --private-key=client.jwk --public-key=client.jwks
+
+		# Generate an EC key pair and write the public key in PEM format
+		verifyctl auth keygen --type=ec --curve=P-384 --private-key=client.jwk --public-key=client.pem --public-format=pem`))
+)
+
+type keygenOptions struct {
+	keyType      string
+	size         int
+	curve        string
+	keyID        string
+	privateKey   string
+	publicKey    string
+	publicFormat string
+
+	config *config.CLIConfig
+}
+
+func newKeygenCommand(config *config.CLIConfig, streams io.ReadWriter) *cobra.Command {
+	o := &keygenOptions{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   keygenUsage,
+		Short:                 cmdutil.TranslateShortDesc(keygenMessagePrefix, "Generate a key pair for private_key_jwt client authentication."),
+		Long:                  keygenLongDesc,
+		Example:               keygenExamples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *keygenOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.keyType, "type", jwk.KeyTypeRSA, i18n.Translate("Type of the key. The values supported are 'rsa' and 'ec'."))
+	cmd.Flags().IntVar(&o.size, "size", jwk.DefaultRSASize, i18n.Translate("Size of the RSA key in bits."))
+	cmd.Flags().StringVar(&o.curve, "curve", "P-256", i18n.Translate("Curve of the EC key. The values supported are 'P-256', 'P-384' and 'P-521'."))
+	cmd.Flags().StringVar(&o.keyID, "kid", "", i18n.Translate("Key ID. Default: the JWK thumbprint of the key."))
+	cmd.Flags().StringVar(&o.privateKey, "private-key", "", i18n.Translate("Path to the file where the private JWK is written."))
+	cmd.Flags().StringVar(&o.publicKey, "public-key", "", i18n.Translate("Path to the file where the public key is written. If not set, the public key is written to the output."))
+	cmd.Flags().StringVar(&o.publicFormat, "public-format", publicFormatJWKS, i18n.Translate("Format of the public key. The values supported are 'jwks' and 'pem'."))
+}
+
+func (o *keygenOptions) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *keygenOptions) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.privateKey) == 0 {
+		return errorsx.G11NError("'private-key' is required.")
+	}
+
+	if o.publicFormat != publicFormatJWKS && o.publicFormat != publicFormatPEM {
+		return errorsx.G11NError("Unsupported public key format '%s'. Use '%s' or '%s'.", o.publicFormat, publicFormatJWKS, publicFormatPEM)
+	}
+
+	if _, err := os.Stat(o.privateKey); err == nil {
+		return errorsx.G11NError("The file '%s' already exists.", o.privateKey)
+	}
+
+	return nil
+}
+
+func (o *keygenOptions) Run(cmd *cobra.Command, args []string) error {
+	key, err := jwk.Generate(o.keyType, o.size, o.curve)
+	if err != nil {
+		return err
+	}
+
+	if len(o.keyID) > 0 {
+		key.KeyID = o.keyID
+	}
+
+	privateKey, err := json.MarshalIndent(key, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	var publicKey []byte
+	if o.publicFormat == publicFormatPEM {
+		publicKey, err = jwk.PublicPEM(key)
+	} else {
+		publicKey, err = json.MarshalIndent(jwk.Public(key), "", "  ")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := writeNewFile(o.privateKey, privateKey, privateKeyPerm); err != nil {
+		return err
+	}
+
+	if len(o.publicKey) == 0 {
+		cmdutil.WriteString(cmd, string(publicKey))
+		return nil
+	}
+
+	if err := os.WriteFile(o.publicKey, publicKey, publicKeyPerm); err != nil {
+		return err
+	}
+
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("Generated the key pair with key ID '%s'.", key.KeyID))
+	return nil
+}
+
+// writeNewFile writes the data to a file that must not exist, so that an existing
+// key is never overwritten, even if the file is created after it was checked.
+func writeNewFile(name string, data []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm)
+	if os.IsExist(err) {
+		return errorsx.G11NError("The file '%s' already exists.", name)
+	} else if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
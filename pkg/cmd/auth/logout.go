@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"io"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	logoutUsage         = "logout [flags]"
+	logoutMessagePrefix = "Logout"
+)
+
+var (
+	logoutLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(logoutMessagePrefix, `
+		End the current session.
+
+The access token and refresh token are revoked on the tenant using the client details saved when the
+session was created. The session is then removed from the configuration file along with the contexts
+that use it.
+
+When the token or API client is provided using flags or environment variables, such as '--token' or
+VERIFY_TOKEN, the tokens are revoked, but no session is removed from the configuration file.
+
+If the tokens cannot be revoked, the session is kept unless '--force' is used.`))
+
+	logoutExamples = templates.Examples(cmdutil.TranslateExamples(logoutMessagePrefix, `
+		# End the current session
+		verifyctl logout
+
+		# End the session of a named context
+		verifyctl logout --context=prod-readonly
+
+		# End all sessions
+		verifyctl logout --all`))
+)
+
+type logoutOptions struct {
+	all   bool
+	force bool
+
+	config *config.CLIConfig
+}
+
+func NewLogoutCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &logoutOptions{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   logoutUsage,
+		Short:                 cmdutil.TranslateShortDesc(logoutMessagePrefix, "End the current session and revoke its tokens."),
+		Long:                  logoutLongDesc,
+		Example:               logoutExamples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *logoutOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.all, "all", o.all, i18n.Translate("End all sessions in the configuration file."))
+	cmd.Flags().BoolVar(&o.force, "force", o.force, i18n.Translate("Remove the session even if the tokens cannot be revoked."))
+}
+
+func (o *logoutOptions) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *logoutOptions) Validate(cmd *cobra.Command, args []string) error {
+	if o.all && len(o.config.SelectedContext) > 0 {
+		return errorsx.G11NError("'--all' cannot be used with '--context'.")
+	}
+
+	return nil
+}
+
+func (o *logoutOptions) Run(cmd *cobra.Command, args []string) error {
+	var auths []*config.AuthConfig
+	if o.all {
+		if err := o.config.LoadCredentials(); err != nil {
+			return err
+		}
+
+		auths = append(auths, o.config.Auth...)
+	} else {
+		auth, err := o.config.GetCurrentAuth()
+		if err != nil {
+			return err
+		}
+
+		auths = append(auths, auth)
+	}
+
+	if len(auths) == 0 {
+		cmdutil.WriteString(cmd, i18n.Translate("No sessions to end."))
+		return nil
+	}
+
+	var failed error
+	var removed []string
+	for _, auth := range auths {
+		if err := o.logout(cmd, auth); err != nil {
+			failed = err
+			continue
+		}
+
+		// entries built from flags or environment variables are named after the
+		// tenant, so they must not remove the session saved for it
+		if !auth.IsEphemeral() {
+			removed = append(removed, auth.Name)
+		}
+	}
+
+	// persist the sessions that were removed, even if others failed
+	err := o.config.Update(func(c *config.CLIConfig) error {
+		for _, name := range removed {
+			c.RemoveAuth(name)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return failed
+}
+
+func (o *logoutOptions) logout(cmd *cobra.Command, auth *config.AuthConfig) error {
+	vc := contextx.GetVerifyContext(cmd.Context())
+	if err := o.config.Revoke(cmd.Context(), auth); err != nil {
+		if !o.force {
+			return errorsx.G11NError("Unable to revoke the tokens for '%s'. Use '--force' to remove the session anyway; err=%v", auth.Name, err)
+		}
+
+		vc.Logger.Warnf("unable to revoke the tokens; name=%s, tenant=%s, err=%v", auth.Name, auth.Tenant, err)
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("The tokens for '%s' were not revoked and remain valid until they expire.", auth.Name))
+	}
+
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("Logged out of '%s'.", auth.Name))
+	return nil
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/go-jose/go-jose/v4"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/jwk"
+	"github.com/ibm-verify/verifyctl/pkg/util/secret"
 	"github.com/spf13/cobra"
 
 	oidc "github.com/ibm-verify/verify-sdk-go/pkg/auth"
@@ -17,13 +20,23 @@ import (
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 )
 
+const (
+	flowDeviceCode        = "device_code"
+	flowAuthorizationCode = "authorization_code"
+)
+
 type AuthResource struct {
 	Tenant string `yaml:"tenant" json:"tenant"`
 
 	ClientID string `yaml:"client_id" json:"client_id"`
 
+	// ClientAuthType is the client authentication method. The values supported are
+	// 'client_secret_post', which is the default, 'client_secret_basic', 'client_secret_jwt',
+	// 'private_key_jwt' and 'tls_client_auth'.
 	ClientAuthType string `yaml:"auth_type" json:"auth_type"`
 
+	// ClientSecret is the client secret or a reference to it, such as 'env:NAME',
+	// 'file:/path' or 'exec:command'.
 	ClientSecret string `yaml:"client_secret" json:"client_secret"`
 
 	Scopes []string `yaml:"scopes" json:"scopes"`
@@ -32,37 +45,92 @@ type AuthResource struct {
 
 	User bool `yaml:"user" json:"user"`
 
+	// Flow is the grant used for user login. The values supported are 'device_code',
+	// which is the default, and 'authorization_code', which opens the browser.
+	Flow string `yaml:"flow,omitempty" json:"flow,omitempty"`
+
+	// RedirectPort is the port of the loopback listener that receives the
+	// authorization code. A free port is chosen if this is not set.
+	RedirectPort int `yaml:"redirect_port,omitempty" json:"redirect_port,omitempty"`
+
+	// PrivateKeyRaw is the private key used for private_key_jwt or tls_client_auth. It can be
+	// a JWK or a PEM encoded private key, or a path to a file containing either, prefixed with '@'.
 	PrivateKeyRaw string `yaml:"key" json:"key"`
 
+	// Certificate is the PEM encoded X.509 certificate of the private key, or a path to the
+	// file prefixed with '@'. The key ID and thumbprints are set using the certificate. It is
+	// the client certificate presented for tls_client_auth.
+	Certificate string `yaml:"certificate,omitempty" json:"certificate,omitempty"`
+
+	// Exec is a credential plugin that prints a token or client credentials, so that the
+	// secrets are managed by an external secret store and not saved by verifyctl.
+	Exec *config.ExecConfig `yaml:"exec,omitempty" json:"exec,omitempty"`
+
 	PrivateKeyJWK *jose.JSONWebKey `yaml:"-" json:"-"`
 }
 
-func (r *AuthResource) ConvertToClient() *oidc.Client {
-	client := &oidc.Client{
-		Tenant: r.Tenant,
-		Scopes: r.Scopes,
+// ConvertToClientConfig returns the client details that are persisted with the
+// session, so that the token can be renewed when it expires.
+func (r *AuthResource) ConvertToClientConfig() (*config.ClientConfig, error) {
+	clientConfig := &config.ClientConfig{
+		ClientID:     r.ClientID,
+		ClientSecret: r.ClientSecret,
+		AuthType:     r.ClientAuthType,
+		Scopes:       r.Scopes,
+		Parameters:   r.Parameters,
 	}
 
-	if r.ClientAuthType == "private_key_jwt" {
-		client.ClientAuth = &oidc.PrivateKeyJWT{
-			Tenant:        r.Tenant,
-			ClientID:      r.ClientID,
-			PrivateKeyJWK: r.PrivateKeyJWK,
-		}
-	} else {
-		client.ClientAuth = &oidc.ClientSecretPost{
-			ClientID:     r.ClientID,
-			ClientSecret: r.ClientSecret,
+	if r.PrivateKeyJWK != nil {
+		b, err := json.Marshal(r.PrivateKeyJWK)
+		if err != nil {
+			return nil, err
 		}
+
+		clientConfig.PrivateKey = string(b)
 	}
 
-	return client
+	return clientConfig, nil
 }
 
 func (o *options) authenticate(cmd *cobra.Command, r *AuthResource) (*oidc.TokenResponse, error) {
 	ctx := cmd.Context()
 	vc := contextx.GetVerifyContext(ctx)
-	client := r.ConvertToClient()
+	if r.Exec != nil {
+		// run the plugin to check that it works
+		auth := &config.AuthConfig{
+			Name:   r.Tenant,
+			Tenant: r.Tenant,
+			Exec:   r.Exec,
+		}
+
+		if err := auth.Renew(ctx); err != nil {
+			return nil, err
+		}
+
+		return &oidc.TokenResponse{
+			AccessToken: auth.Token,
+		}, nil
+	}
+
+	clientConfig, err := r.ConvertToClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientConfig.ConvertToClient(r.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	// present the client certificate for tls_client_auth
+	ctx, err = clientConfig.WithHTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Flow == flowAuthorizationCode {
+		return o.authenticateWithBrowser(ctx, cmd, r, client)
+	}
 
 	if r.User {
 		deviceAuthResponse, err := client.AuthorizeWithDeviceFlow(ctx, r.Parameters)
@@ -82,7 +150,7 @@ func (o *options) authenticate(cmd *cobra.Command, r *AuthResource) (*oidc.Token
 		return tokenResponse, nil
 	}
 
-	tokenResponse, err := client.TokenWithAPIClient(cmd.Context(), r.Parameters)
+	tokenResponse, err := clientConfig.TokenWithAPIClient(ctx, r.Tenant)
 	if err != nil {
 		vc.Logger.Errorf("Unable to get a token: err=%v", err)
 		return nil, err
@@ -117,27 +185,50 @@ func (o *options) readFile(cmd *cobra.Command) (*AuthResource, error) {
 		return nil, err
 	}
 
+	switch authResource.Flow {
+	case "", flowDeviceCode:
+	case flowAuthorizationCode:
+		// the authorization code flow is only used for user login
+		authResource.User = true
+	default:
+		return nil, errorsx.G11NError("Unsupported flow '%s'. Use '%s' or '%s'.", authResource.Flow, flowDeviceCode, flowAuthorizationCode)
+	}
+
 	// if the private key is provided, extract the key
 	if authResource.PrivateKeyRaw == "" {
 		return authResource, nil
 	}
 
-	jwkAsString := authResource.PrivateKeyRaw
-	if strings.HasPrefix(authResource.PrivateKeyRaw, "@") {
-		// get the contents of the file
-		path, _ := strings.CutPrefix(authResource.PrivateKeyRaw, "@")
-		b, err := os.ReadFile(path)
-		if err != nil {
-			return nil, err
-		}
+	key, err := readValue(authResource.PrivateKeyRaw)
+	if err != nil {
+		return nil, err
+	}
 
-		jwkAsString = string(b)
+	certificate, err := readValue(authResource.Certificate)
+	if err != nil {
+		return nil, err
 	}
 
-	authResource.PrivateKeyJWK = &jose.JSONWebKey{}
-	if err := json.Unmarshal([]byte(jwkAsString), authResource.PrivateKeyJWK); err != nil {
+	authResource.PrivateKeyJWK, err = jwk.Parse(key, certificate)
+	if err != nil {
 		return nil, err
 	}
 
 	return authResource, nil
 }
+
+// readValue returns the contents of the file if the value is prefixed with '@'.
+// Other secret references, such as 'env:NAME', are resolved too.
+func readValue(value string) (string, error) {
+	path, ok := strings.CutPrefix(value, "@")
+	if !ok {
+		return secret.Resolve(value)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
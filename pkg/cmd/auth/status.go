@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	statusUsage         = "status [flags]"
+	whoAmIUsage         = "whoami [flags]"
+	statusMessagePrefix = "AuthStatus"
+)
+
+var (
+	statusLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(statusMessagePrefix, `
+		Display the tenant and the identity of the current session.
+
+The details include the subject, client ID, grant type, issue and expiry times, and the scopes and
+entitlements granted to the token. This helps identify the missing entitlements when a command fails
+with a '403 Forbidden' error.
+
+JWT access tokens are decoded locally. Other tokens are sent to the introspection endpoint of the tenant
+using the client details saved when the session was created. Use '--introspect' to always call the tenant,
+for example, to check if the token has been revoked.`))
+
+	statusExamples = templates.Examples(cmdutil.TranslateExamples(statusMessagePrefix, `
+		# Display the current session
+		verifyctl auth status
+
+		# Display the session of a named context in JSON format
+		verifyctl whoami --context=prod-readonly -o=json
+
+		# Check the token with the tenant
+		verifyctl whoami --introspect`))
+)
+
+type statusOptions struct {
+	output     string
+	introspect bool
+
+	config *config.CLIConfig
+}
+
+// sessionStatus is the output of the command.
+type sessionStatus struct {
+	Context string `yaml:"context,omitempty" json:"context,omitempty"`
+	Tenant  string `yaml:"tenant" json:"tenant"`
+	Type    string `yaml:"type" json:"type"`
+
+	*config.TokenInfo `yaml:",inline"`
+}
+
+// NewWhoAmICommand creates the 'whoami' command, which is the same as 'auth status'.
+func NewWhoAmICommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	cmd := newStatusCommand(config, streams, whoAmIUsage)
+	cmd.GroupID = groupID
+	return cmd
+}
+
+func newStatusCommand(config *config.CLIConfig, streams io.ReadWriter, usage string) *cobra.Command {
+	o := &statusOptions{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   usage,
+		Short:                 cmdutil.TranslateShortDesc(statusMessagePrefix, "Display the tenant and the identity of the current session."),
+		Long:                  statusLongDesc,
+		Example:               statusExamples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *statusOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.output, "output", "o", "", i18n.Translate("Select the format of the output. The values supported are 'json' and 'yaml'. Default: text."))
+	cmd.Flags().BoolVar(&o.introspect, "introspect", o.introspect, i18n.Translate("Get the token details from the introspection endpoint of the tenant, even if the token can be decoded locally."))
+}
+
+func (o *statusOptions) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *statusOptions) Validate(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *statusOptions) Run(cmd *cobra.Command, args []string) error {
+	auth, err := o.config.SetAuthToContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	var info *config.TokenInfo
+	if !o.introspect {
+		info = auth.DecodeToken()
+	}
+
+	if info == nil {
+		info, err = auth.Introspect(cmd.Context())
+		if err != nil {
+			return err
+		}
+	}
+
+	status := &sessionStatus{
+		Tenant:    auth.Tenant,
+		Type:      "client",
+		TokenInfo: info,
+	}
+
+	if !auth.IsEphemeral() {
+		status.Context = o.config.CurrentContextName()
+	}
+
+	if auth.User {
+		status.Type = "user"
+	} else if auth.Exec != nil {
+		status.Type = "exec"
+	}
+
+	switch o.output {
+	case "json":
+		cmdutil.WriteAsJSON(cmd, status, cmd.OutOrStdout())
+	case "yaml":
+		cmdutil.WriteAsYAML(cmd, status, cmd.OutOrStdout())
+	default:
+		return writeStatus(cmd, status)
+	}
+
+	return nil
+}
+
+func writeStatus(cmd *cobra.Command, status *sessionStatus) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 10, 1, 2, ' ', 0)
+	rows := [][]string{
+		{"Context", status.Context},
+		{"Tenant", status.Tenant},
+		{"Type", status.Type},
+		{"Active", fmt.Sprintf("%t", status.Active)},
+		{"Subject", status.Subject},
+		{"Client ID", status.ClientID},
+		{"Grant type", status.GrantType},
+		{"Issued at", formatTime(status.IssuedAt)},
+		{"Expires at", formatTime(status.ExpiresAt)},
+		{"Scopes", strings.Join(status.Scopes, " ")},
+		{"Entitlements", strings.Join(status.Entitlements, " ")},
+		{"Source", status.Source},
+	}
+
+	for _, row := range rows {
+		if len(row[1]) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", row[0], row[1])
+	}
+
+	return w.Flush()
+}
+
+func formatTime(t int64) string {
+	if t == 0 {
+		return ""
+	}
+
+	return time.Unix(t, 0).Format(time.RFC3339)
+}
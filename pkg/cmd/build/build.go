@@ -0,0 +1,147 @@
+package build
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	usage         = "build DIRECTORY [options]"
+	messagePrefix = "Build"
+)
+
+var (
+	shortDesc = cmdutil.TranslateShortDesc(messagePrefix, "Print the resources in a directory with the overlays applied.")
+
+	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
+		Print the resources in a directory or file with the overlays applied.
+
+An overlay is a resource of the kind 'IBMVerifyOverlay' that includes the resources in other files or
+directories and patches them. This allows a base definition to be shared by several tenants, while
+only the differences are kept for each of them. The patches are applied to the resources read with
+the overlay, in order, and identify the resource by its kind and name. Each patch is either a JSON
+merge patch (RFC 7396) or a JSON patch (RFC 6902):
+
+  kind: IBMVerifyOverlay
+  apiVersion: "1.0"
+  data:
+    resources:
+      - ../base
+    patches:
+      - target:
+          kind: IBMVerifyApplication
+          name: My App
+        mergePatch:
+          description: Production
+      - target:
+          kind: IBMVerifyApplication
+          name: My App
+        jsonPatch:
+          - op: replace
+            path: /providers/oidc/properties/redirectUris/0
+            value: https://app.example.com/callback
+
+Relative paths in 'resources' are relative to the directory of the overlay. The commands that read
+resource files, such as 'apply', apply the overlays in the same way, so this command can be used to
+check the result before it is applied. The files are read in the same way as 'apply', and secret
+references are printed without being resolved. For more information:
+
+  verifyctl apply -h`))
+
+	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
+		# Print the resources for the production tenant
+		verifyctl build ./overlays/prod
+
+		# Apply the resources for the production tenant
+		verifyctl apply -f=./overlays/prod`))
+)
+
+type options struct {
+	recursive bool
+
+	config *config.CLIConfig
+}
+
+func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &options{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   usage,
+		Short:                 shortDesc,
+		Long:                  longDesc,
+		Example:               examples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&o.recursive, "recursive", "R", o.recursive, i18n.Translate("Read the files in the subdirectories as well when the input is a directory."))
+	resource.AddTemplateFlags(cmd)
+}
+
+func (o *options) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *options) Validate(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errorsx.G11NError("A directory or file is required.")
+	}
+
+	return nil
+}
+
+func (o *options) Run(cmd *cobra.Command, args []string) error {
+	vc := contextx.GetVerifyContext(cmd.Context())
+
+	resourceObjects, err := resource.BuildObjects(cmd, args[0], o.recursive)
+	if err != nil {
+		vc.Logger.Errorf("unable to read the resources; err=%v", err)
+		return err
+	}
+
+	b := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(b)
+	encoder.SetIndent(2)
+	for _, resourceObject := range resourceObjects {
+		if err := encoder.Encode(resourceObject); err != nil {
+			vc.Logger.Errorf("unable to marshal the resource; err=%v", err)
+			return err
+		}
+	}
+
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	cmdutil.WriteString(cmd, strings.TrimSuffix(b.String(), "\n"))
+	return nil
+}
@@ -4,12 +4,20 @@ import (
 	"io"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/apply"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/auth"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/build"
+	configcmd "github.com/ibm-verify/verifyctl/pkg/cmd/config"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/create"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/delete"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/diff"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/explain"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/export"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/get"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/logs"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/replace"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/set"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/validate"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
@@ -30,6 +38,11 @@ func NewRootCmd(config *config.CLIConfig, streams io.ReadWriter) *cobra.Command
 		Short: cmdutil.TranslateShortDesc(messagePrefix, "verifyctl controls the IBM Security Verify tenant."),
 		Long: templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `verifyctl controls the IBM Security Verify tenant.
 
+  Commands use the session saved by 'verifyctl auth'. In CI pipelines, where a configuration file is
+  not available, the tenant and credentials can be provided using the VERIFY_TENANT, VERIFY_TOKEN,
+  VERIFY_CLIENT_ID, VERIFY_CLIENT_SECRET and VERIFY_PRIVATE_KEY environment variables. When a client
+  ID is provided instead of a token, a token is obtained for each command and is not saved.
+
   Find more information at: https://github.com/ibm-verify/verifyctl`)),
 	}
 
@@ -37,12 +50,31 @@ func NewRootCmd(config *config.CLIConfig, streams io.ReadWriter) *cobra.Command
 	cmd.SetErr(streams)
 	cmd.SetIn(streams)
 
+	cmd.PersistentFlags().StringVar(&config.SelectedContext, "context", "", i18n.Translate("Name of the context to use. This overrides the current context in the configuration file."))
+	cmd.PersistentFlags().StringVar(&config.TenantOverride, "tenant", "", i18n.Translate("Tenant to use instead of the current context. This can also be set using the VERIFY_TENANT environment variable."))
+	cmd.PersistentFlags().StringVar(&config.TokenOverride, "token", "", i18n.Translate("Access token to use instead of the session in the configuration file. This can also be set using the VERIFY_TOKEN environment variable."))
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return config.ApplyDefaults(cmd)
+	}
+
 	// add commands
 	cmd.AddCommand(auth.NewCommand(config, streams, basicGroupID))
+	cmd.AddCommand(auth.NewWhoAmICommand(config, streams, basicGroupID))
+	cmd.AddCommand(auth.NewLogoutCommand(config, streams, basicGroupID))
+	cmd.AddCommand(configcmd.NewCommand(config, streams, basicGroupID))
 	cmd.AddCommand(get.NewCommand(config, streams, resourceGroupID))
 	cmd.AddCommand(create.NewCommand(config, streams, resourceGroupID))
 	cmd.AddCommand(replace.NewCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(set.NewCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(apply.NewCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(diff.NewCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(build.NewCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(validate.NewCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(explain.NewCommand(config, streams, resourceGroupID))
 	cmd.AddCommand(delete.NewCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(export.NewCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(export.NewImportCommand(config, streams, resourceGroupID))
+	cmd.AddCommand(export.NewPromoteCommand(config, streams, resourceGroupID))
 	cmd.AddCommand(logs.NewCommand(config, streams, debugGroupID))
 
 	// add groups
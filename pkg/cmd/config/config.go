@@ -0,0 +1,76 @@
+package config
+
+import (
+	"io"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	cliconfig "github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	usage         = "config [command] [flags]"
+	messagePrefix = "Config"
+)
+
+var (
+	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
+		Manage the local configuration file at your home directory under ".verify/config".
+
+The configuration holds the login sessions created using the 'auth' command. Each session
+is saved as a named context that pairs a tenant with a credential. The commands in this
+group let you view and switch between sessions without logging in again.`))
+
+	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
+		# View the configuration with tokens and secrets redacted
+		verifyctl config view
+
+		# List the available tenants and contexts
+		verifyctl config get-tenants
+
+		# Switch to another context
+		verifyctl config use-tenant prod-admin`))
+)
+
+type options struct {
+	config *cliconfig.CLIConfig
+}
+
+func NewCommand(config *cliconfig.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   usage,
+		Short:                 cmdutil.TranslateShortDesc(messagePrefix, "Manage the local configuration."),
+		Long:                  longDesc,
+		Example:               examples,
+		DisableFlagsInUseLine: true,
+		GroupID:               groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	// add sub commands
+	cmd.AddCommand(newViewCommand(config, streams))
+	cmd.AddCommand(newGetTenantsCommand(config, streams))
+	cmd.AddCommand(newUseTenantCommand(config, streams))
+	cmd.AddCommand(newDeleteTenantCommand(config, streams))
+	cmd.AddCommand(newRenameCommand(config, streams))
+	cmd.AddCommand(newSetCredentialStoreCommand(config, streams))
+	cmd.AddCommand(newMigrateCommand(config, streams))
+
+	return cmd
+}
+
+// update applies the changes to the latest contents of the configuration file
+// and prints the message once they are saved.
+func (o *options) update(cmd *cobra.Command, update func(c *cliconfig.CLIConfig) error, message string, args ...any) error {
+	if err := o.config.Update(update); err != nil {
+		return err
+	}
+
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs(message, args...))
+	return nil
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"io"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	cliconfig "github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	deleteTenantUsage         = "delete-tenant NAME"
+	deleteTenantMessagePrefix = "ConfigDeleteTenant"
+)
+
+var (
+	deleteTenantLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(deleteTenantMessagePrefix, `
+		Delete a context and its session from the local configuration.
+		
+If the name is a tenant, all contexts and sessions for the tenant are deleted. The token
+is only removed from the configuration file and is not revoked.`))
+
+	deleteTenantExamples = templates.Examples(cmdutil.TranslateExamples(deleteTenantMessagePrefix, `
+		# Delete all sessions for a tenant
+		verifyctl config delete-tenant abc.verify.ibm.com
+
+		# Delete a named context
+		verifyctl config delete-tenant prod-readonly`))
+)
+
+type deleteTenantOptions struct {
+	options
+	name string
+}
+
+func newDeleteTenantCommand(config *cliconfig.CLIConfig, streams io.ReadWriter) *cobra.Command {
+	o := &deleteTenantOptions{
+		options: options{
+			config: config,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:                   deleteTenantUsage,
+		Short:                 cmdutil.TranslateShortDesc(deleteTenantMessagePrefix, "Delete a context from the local configuration."),
+		Long:                  deleteTenantLongDesc,
+		Example:               deleteTenantExamples,
+		Aliases:               []string{"delete-context"},
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	return cmd
+}
+
+func (o *deleteTenantOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.name = args[0]
+	}
+
+	return nil
+}
+
+func (o *deleteTenantOptions) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.name) == 0 {
+		return errorsx.G11NError("Context name or tenant is required.")
+	}
+
+	return nil
+}
+
+func (o *deleteTenantOptions) Run(cmd *cobra.Command, args []string) error {
+	return o.update(cmd, func(c *cliconfig.CLIConfig) error {
+		return c.DeleteContext(o.name)
+	}, "Deleted context '%s'.", o.name)
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	cliconfig "github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	getTenantsUsage         = "get-tenants"
+	getTenantsMessagePrefix = "ConfigGetTenants"
+)
+
+var (
+	getTenantsLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(getTenantsMessagePrefix, `
+		List the tenants and contexts available in the local configuration.
+		
+The current context is marked with '*'.`))
+
+	getTenantsExamples = templates.Examples(cmdutil.TranslateExamples(getTenantsMessagePrefix, `
+		# List the tenants
+		verifyctl config get-tenants`))
+)
+
+type getTenantsOptions struct {
+	options
+}
+
+func newGetTenantsCommand(config *cliconfig.CLIConfig, streams io.ReadWriter) *cobra.Command {
+	o := &getTenantsOptions{
+		options: options{
+			config: config,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:                   getTenantsUsage,
+		Short:                 cmdutil.TranslateShortDesc(getTenantsMessagePrefix, "List the tenants and contexts in the local configuration."),
+		Long:                  getTenantsLongDesc,
+		Example:               getTenantsExamples,
+		Aliases:               []string{"get-contexts"},
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	return cmd
+}
+
+func (o *getTenantsOptions) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *getTenantsOptions) Validate(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *getTenantsOptions) Run(cmd *cobra.Command, args []string) error {
+	current := o.config.CurrentContextName()
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 10, 1, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "CURRENT\tNAME\tTENANT\tTYPE\tEXPIRES")
+	for _, auth := range o.config.Auth {
+		names := []string{}
+		for _, c := range o.config.Contexts {
+			if c.AuthName() == auth.Name {
+				names = append(names, c.Name)
+			}
+		}
+
+		// auth entries may not be referenced by a context
+		if len(names) == 0 {
+			names = append(names, auth.Name)
+		}
+
+		for _, name := range names {
+			marker := ""
+			if name == current {
+				marker = "*"
+			}
+
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", marker, name, auth.Tenant, authType(auth), expiry(auth))
+		}
+	}
+
+	return w.Flush()
+}
+
+func authType(auth *cliconfig.AuthConfig) string {
+	if auth.User {
+		return "user"
+	}
+
+	if auth.Exec != nil {
+		return "exec"
+	}
+
+	return "client"
+}
+
+func expiry(auth *cliconfig.AuthConfig) string {
+	if auth.ExpiresAt == 0 {
+		return "unknown"
+	}
+
+	return time.Unix(auth.ExpiresAt, 0).Format(time.RFC3339)
+}
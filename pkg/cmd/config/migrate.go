@@ -0,0 +1,109 @@
+package config
+
+import (
+	"io"
+	"strings"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	cliconfig "github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/diff"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	migrateUsage         = "migrate [flags]"
+	migrateMessagePrefix = "ConfigMigrate"
+)
+
+var (
+	migrateLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(migrateMessagePrefix, `
+		Upgrade the local configuration file to the version used by this release.
+
+Files created by older releases are upgraded in memory when they are read and saved
+the next time the configuration changes. This command saves the upgraded file right away.
+The previous contents are kept in a backup next to the file, such as '.verify/config.v1.0.bak'.
+
+Use '--dry-run' to display the changes without saving them. Tokens, client secrets and
+private keys are redacted.`))
+
+	migrateExamples = templates.Examples(cmdutil.TranslateExamples(migrateMessagePrefix, `
+		# Display the changes made to the configuration file
+		verifyctl config migrate --dry-run
+
+		# Upgrade the configuration file
+		verifyctl config migrate`))
+)
+
+type migrateOptions struct {
+	options
+	dryRun bool
+}
+
+func newMigrateCommand(config *cliconfig.CLIConfig, streams io.ReadWriter) *cobra.Command {
+	o := &migrateOptions{
+		options: options{
+			config: config,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:                   migrateUsage,
+		Short:                 cmdutil.TranslateShortDesc(migrateMessagePrefix, "Upgrade the local configuration file."),
+		Long:                  migrateLongDesc,
+		Example:               migrateExamples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *migrateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.dryRun, "dry-run", o.dryRun, i18n.Translate("Display the changes without saving them."))
+}
+
+func (o *migrateOptions) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *migrateOptions) Validate(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *migrateOptions) Run(cmd *cobra.Command, args []string) error {
+	migration, err := o.config.Migrate()
+	if err != nil {
+		return err
+	}
+
+	if migration.From == migration.To {
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("The configuration file is at version '%s'. No changes are needed.", migration.To))
+		return nil
+	}
+
+	if o.dryRun {
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("The configuration file would be migrated from version '%s' to '%s' and backed up to '%s'.", migration.From, migration.To, migration.Backup))
+		changes := diff.Unified(migration.Path+" (v"+migration.From+")", migration.Path+" (v"+migration.To+")", migration.Before, migration.After)
+		if len(changes) > 0 {
+			cmdutil.WriteString(cmd, strings.TrimSuffix(changes, "\n"))
+		}
+
+		return nil
+	}
+
+	return o.update(cmd, func(c *cliconfig.CLIConfig) error {
+		return nil
+	}, "Migrated the configuration file from version '%s' to '%s'. The previous contents are saved in '%s'.", migration.From, migration.To, migration.Backup)
+}
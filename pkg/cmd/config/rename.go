@@ -0,0 +1,84 @@
+package config
+
+import (
+	"io"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	cliconfig "github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	renameUsage         = "rename OLD_NAME NEW_NAME"
+	renameMessagePrefix = "ConfigRename"
+)
+
+var (
+	renameLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(renameMessagePrefix, `
+		Rename a context in the local configuration.
+		
+The auth entry with the same name is renamed as well.`))
+
+	renameExamples = templates.Examples(cmdutil.TranslateExamples(renameMessagePrefix, `
+		# Rename the context created for a tenant
+		verifyctl config rename abc.verify.ibm.com prod-admin`))
+)
+
+type renameOptions struct {
+	options
+	oldName string
+	newName string
+}
+
+func newRenameCommand(config *cliconfig.CLIConfig, streams io.ReadWriter) *cobra.Command {
+	o := &renameOptions{
+		options: options{
+			config: config,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:                   renameUsage,
+		Short:                 cmdutil.TranslateShortDesc(renameMessagePrefix, "Rename a context."),
+		Long:                  renameLongDesc,
+		Example:               renameExamples,
+		Aliases:               []string{"rename-context"},
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	return cmd
+}
+
+func (o *renameOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		o.oldName = args[0]
+		o.newName = args[1]
+	}
+
+	return nil
+}
+
+func (o *renameOptions) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.oldName) == 0 || len(o.newName) == 0 {
+		return errorsx.G11NError("The current and new names are required.")
+	}
+
+	return nil
+}
+
+func (o *renameOptions) Run(cmd *cobra.Command, args []string) error {
+	return o.update(cmd, func(c *cliconfig.CLIConfig) error {
+		return c.RenameContext(o.oldName, o.newName)
+	}, "Renamed context '%s' to '%s'.", o.oldName, o.newName)
+}
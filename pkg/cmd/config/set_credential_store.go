@@ -0,0 +1,98 @@
+package config
+
+import (
+	"io"
+	"strings"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	cliconfig "github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	setCredentialStoreUsage         = "set-credential-store NAME"
+	setCredentialStoreMessagePrefix = "ConfigSetCredentialStore"
+)
+
+var (
+	setCredentialStoreLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(setCredentialStoreMessagePrefix, `
+		Set where tokens, client secrets and private keys are stored.
+
+The existing credentials are moved to the new store. The supported stores are:
+
+  - plain: The tokens are saved in the configuration file. This is the default. Client secrets and
+    private keys are not saved, so API client sessions cannot be renewed and require logging in again.
+  - keyring: The credentials are saved in the OS keyring. On Linux, this uses the Secret Service
+    through 'secret-tool'. On macOS, this uses the login keychain.
+  - file: The credentials are saved in ".verify/credentials", encrypted using the passphrase
+    set in the VERIFY_CREDENTIAL_PASSPHRASE environment variable.
+
+The configuration file holds only a reference to the credentials when the 'keyring' or 'file'
+store is used. The store can be overridden using the VERIFY_CREDENTIAL_STORE environment variable, but
+it cannot be changed while the variable is set.`))
+
+	setCredentialStoreExamples = templates.Examples(cmdutil.TranslateExamples(setCredentialStoreMessagePrefix, `
+		# Save credentials in the OS keyring
+		verifyctl config set-credential-store keyring
+
+		# Save credentials in an encrypted file
+		export VERIFY_CREDENTIAL_PASSPHRASE=...
+		verifyctl config set-credential-store file`))
+)
+
+type setCredentialStoreOptions struct {
+	options
+	name string
+}
+
+func newSetCredentialStoreCommand(config *cliconfig.CLIConfig, streams io.ReadWriter) *cobra.Command {
+	o := &setCredentialStoreOptions{
+		options: options{
+			config: config,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:                   setCredentialStoreUsage,
+		Short:                 cmdutil.TranslateShortDesc(setCredentialStoreMessagePrefix, "Set where tokens and secrets are stored."),
+		Long:                  setCredentialStoreLongDesc,
+		Example:               setCredentialStoreExamples,
+		DisableFlagsInUseLine: true,
+		ValidArgs:             cliconfig.CredentialStoreNames,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	return cmd
+}
+
+func (o *setCredentialStoreOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.name = args[0]
+	}
+
+	return nil
+}
+
+func (o *setCredentialStoreOptions) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.name) == 0 {
+		return errorsx.G11NError("Credential store is required. Use one of: %s", strings.Join(cliconfig.CredentialStoreNames, ", "))
+	}
+
+	return nil
+}
+
+func (o *setCredentialStoreOptions) Run(cmd *cobra.Command, args []string) error {
+	return o.update(cmd, func(c *cliconfig.CLIConfig) error {
+		return c.SetCredentialStore(o.name)
+	}, "Credentials are stored using '%s'.", o.name)
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"io"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	cliconfig "github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	useTenantUsage         = "use-tenant NAME"
+	useTenantMessagePrefix = "ConfigUseTenant"
+)
+
+var (
+	useTenantLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(useTenantMessagePrefix, `
+		Switch the current context.
+		
+The name may be a context name or a tenant. If a tenant has more than one session, the
+context name must be used.`))
+
+	useTenantExamples = templates.Examples(cmdutil.TranslateExamples(useTenantMessagePrefix, `
+		# Switch to a tenant
+		verifyctl config use-tenant abc.verify.ibm.com
+
+		# Switch to a named context
+		verifyctl config use-tenant prod-readonly`))
+)
+
+type useTenantOptions struct {
+	options
+	name string
+}
+
+func newUseTenantCommand(config *cliconfig.CLIConfig, streams io.ReadWriter) *cobra.Command {
+	o := &useTenantOptions{
+		options: options{
+			config: config,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:                   useTenantUsage,
+		Short:                 cmdutil.TranslateShortDesc(useTenantMessagePrefix, "Switch the current context."),
+		Long:                  useTenantLongDesc,
+		Example:               useTenantExamples,
+		Aliases:               []string{"use-context"},
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	return cmd
+}
+
+func (o *useTenantOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.name = args[0]
+	}
+
+	return nil
+}
+
+func (o *useTenantOptions) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.name) == 0 {
+		return errorsx.G11NError("Context name or tenant is required.")
+	}
+
+	return nil
+}
+
+func (o *useTenantOptions) Run(cmd *cobra.Command, args []string) error {
+	var name string
+	err := o.config.Update(func(c *cliconfig.CLIConfig) error {
+		if err := c.UseContext(o.name); err != nil {
+			return err
+		}
+
+		name = c.CurrentContext
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("Switched to context '%s'.", name))
+	return nil
+}
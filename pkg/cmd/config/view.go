@@ -0,0 +1,153 @@
+package config
+
+import (
+	"io"
+	"net/url"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	cliconfig "github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+const (
+	viewUsage         = "view [flags]"
+	viewMessagePrefix = "ConfigView"
+	redacted          = "REDACTED"
+)
+
+var (
+	viewLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(viewMessagePrefix, `
+		Display the local configuration.
+		
+Tokens, client secrets, private keys, the values of the client parameters and the environment of
+credential plugins are redacted unless the '--raw' flag is used.`))
+
+	viewExamples = templates.Examples(cmdutil.TranslateExamples(viewMessagePrefix, `
+		# View the configuration
+		verifyctl config view
+
+		# View the configuration in JSON format
+		verifyctl config view -o=json`))
+)
+
+type viewOptions struct {
+	options
+	output string
+	raw    bool
+}
+
+func newViewCommand(config *cliconfig.CLIConfig, streams io.ReadWriter) *cobra.Command {
+	o := &viewOptions{
+		options: options{
+			config: config,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:                   viewUsage,
+		Short:                 cmdutil.TranslateShortDesc(viewMessagePrefix, "Display the local configuration."),
+		Long:                  viewLongDesc,
+		Example:               viewExamples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *viewOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.output, "output", "o", "", i18n.Translate("Select the format of the output. The values supported are 'json' and 'yaml'. Default: 'yaml'."))
+	cmd.Flags().BoolVar(&o.raw, "raw", o.raw, i18n.Translate("Display tokens, client secrets, private keys, client parameters and the environment of credential plugins without redacting them."))
+}
+
+func (o *viewOptions) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *viewOptions) Validate(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *viewOptions) Run(cmd *cobra.Command, args []string) error {
+	config := o.config
+	if o.raw {
+		// read the secrets held in the credential store
+		if err := config.LoadCredentials(); err != nil {
+			return err
+		}
+	} else {
+		config = redact(o.config)
+	}
+
+	if o.output == "json" {
+		cmdutil.WriteAsJSON(cmd, config, cmd.OutOrStdout())
+	} else {
+		cmdutil.WriteAsYAML(cmd, config, cmd.OutOrStdout())
+	}
+
+	return nil
+}
+
+// redact returns a copy of the configuration with the secrets replaced.
+func redact(config *cliconfig.CLIConfig) *cliconfig.CLIConfig {
+	c := *config
+	c.Auth = []*cliconfig.AuthConfig{}
+	for _, auth := range config.Auth {
+		a := *auth
+		a.Token = redactValue(a.Token)
+		a.RefreshToken = redactValue(a.RefreshToken)
+		if a.Client != nil {
+			client := *a.Client
+			client.ClientSecret = redactValue(client.ClientSecret)
+			client.PrivateKey = redactValue(client.PrivateKey)
+			if client.Parameters != nil {
+				client.Parameters = url.Values{}
+				for name, values := range a.Client.Parameters {
+					for _, value := range values {
+						client.Parameters.Add(name, redactValue(value))
+					}
+				}
+			}
+
+			a.Client = &client
+		}
+
+		if a.Exec != nil {
+			// the environment of the plugin usually holds the secrets it needs
+			exec := *a.Exec
+			exec.Args = append([]string(nil), a.Exec.Args...)
+			if exec.Env != nil {
+				exec.Env = map[string]string{}
+				for name, value := range a.Exec.Env {
+					exec.Env[name] = redactValue(value)
+				}
+			}
+
+			a.Exec = &exec
+		}
+
+		c.Auth = append(c.Auth, &a)
+	}
+
+	return &c
+}
+
+func redactValue(value string) string {
+	if len(value) == 0 {
+		return value
+	}
+
+	return redacted
+}
@@ -1,9 +1,7 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
@@ -13,6 +11,7 @@ import (
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -20,11 +19,12 @@ import (
 const (
 	accessPolicyUsage         = "accesspolicy [options]"
 	accessPolicyMessagePrefix = "CreateAccessPolicy"
-	accessPolicyEntitlements  = "Manage Access Policies"
 	accessPolicyResourceName  = "accesspolicy"
 )
 
 var (
+	accessPolicyEntitlements = config.Entitlements{config.EntitlementManageAccessPolicies}
+
 	accessPolicyShortDesc = cmdutil.TranslateShortDesc(accessPolicyMessagePrefix, "Additional options to create a accessPolicy.")
 
 	accessPolicyLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(accessPolicyMessagePrefix, `
@@ -107,7 +107,7 @@ func (o *accessPolicyOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *accessPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+accessPolicyEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+accessPolicyEntitlements.String())
 		return nil
 	}
 
@@ -122,7 +122,16 @@ func (o *accessPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"AccessPolicy")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), accessPolicyEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -131,12 +140,8 @@ func (o *accessPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *accessPolicyOptions) createAccessPolicy(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -162,30 +167,3 @@ func (o *accessPolicyOptions) createAccessPolicyWithData(cmd *cobra.Command, dat
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *accessPolicyOptions) createAccessPolicyFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	accessPolicy := &security.Policy{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, accessPolicy); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an accessPolicy; err=%v", err)
-		return err
-	}
-
-	client := security.NewAccessPolicyClient()
-	resourceURI, err := client.CreateAccessPolicy(ctx, accessPolicy)
-	if err != nil {
-		vc.Logger.Errorf("unable to create the accessPolicy; err=%v, accessPolicy=%+v", err, accessPolicy)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
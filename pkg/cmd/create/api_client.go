@@ -1,14 +1,13 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"gopkg.in/yaml.v3"
 
@@ -20,11 +19,12 @@ import (
 const (
 	apiClientUsage         = "apiclient [options]"
 	apiClientMessagePrefix = "CreateApiClient"
-	apiClientEntitlements  = "Manage API Clients"
 	apiClientResourceName  = "apiclient"
 )
 
 var (
+	apiClientEntitlements = config.Entitlements{config.EntitlementManageAPIClients}
+
 	apiClientShortDesc = cmdutil.TranslateShortDesc(apiClientMessagePrefix, "Options to create an API client.")
 
 	apiClientLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(apiClientMessagePrefix, `
@@ -106,12 +106,12 @@ func (o *apiClientOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *apiClientOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+apiClientEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+apiClientEntitlements.String())
 		return nil
 	}
 	if o.boilerplate {
 		resourceObj := &resource.ResourceObject{
-			Kind:       resource.ResourceTypePrefix + "ApiClient",
+			Kind:       resource.ResourceTypePrefix + "APIClient",
 			APIVersion: "1.0",
 			Data:       security.APIClientExample(),
 		}
@@ -120,7 +120,16 @@ func (o *apiClientOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.GetCurrentAuth()
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"APIClient")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), apiClientEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -129,12 +138,8 @@ func (o *apiClientOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *apiClientOptions) createAPIClient(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -168,37 +173,3 @@ func (o *apiClientOptions) createAPIClientWithData(cmd *cobra.Command, data []by
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *apiClientOptions) createAPIClientFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	apiclient := &security.APIClientConfig{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal data; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, apiclient); err != nil {
-		vc.Logger.Errorf("unable to unmarshal data to API client; err=%v", err)
-		return err
-	}
-
-	if apiclient.ClientName == "" {
-		return errorsx.G11NError("clientName is required")
-	}
-	if len(apiclient.Entitlements) == 0 {
-		return errorsx.G11NError("entitlements list is required")
-	}
-
-	client := security.NewAPIClient()
-	resourceURI, err := client.CreateAPIClient(ctx, apiclient)
-	if err != nil {
-		vc.Logger.Errorf("failed to create API client; err=%v", err)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
@@ -1,9 +1,7 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/applications"
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
@@ -12,6 +10,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -20,11 +19,12 @@ import (
 const (
 	applicationUsage         = "application [options]"
 	applicationMessagePrefix = "CreateApplication"
-	applicationEntitlements  = "Manage applications"
 	applicationResourceName  = "application"
 )
 
 var (
+	applicationEntitlements = config.Entitlements{config.EntitlementManageAppAccessAdmin}
+
 	applicationLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(applicationMessagePrefix, `
         Create a Verify Application using a file input.
         Resources managed on Verify have specific entitlements, so ensure that the application or API client used with the 'auth' command is configured with the appropriate entitlements.
@@ -94,14 +94,14 @@ func (o *applicationOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *applicationOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+" "+applicationEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+applicationEntitlements.String())
 		return nil
 	}
 
 	if o.boilerplate {
 		if o.applicationType == "saml" || o.applicationType == "oidc" || o.applicationType == "aclc" || o.applicationType == "bookmark" || o.applicationType == "" {
 			resourceObj := &resource.ResourceObject{
-				Kind:       resource.ResourceTypePrefix + "Applications",
+				Kind:       resource.ResourceTypePrefix + "Application",
 				APIVersion: "1.0",
 				Data:       applications.ApplicationExample(o.applicationType),
 			}
@@ -112,7 +112,16 @@ func (o *applicationOptions) Run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"Application")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), applicationEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -121,12 +130,8 @@ func (o *applicationOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *applicationOptions) createApplication(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -152,30 +157,3 @@ func (o *applicationOptions) createApplicationWithData(cmd *cobra.Command, data
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *applicationOptions) createApplicationFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	application := &applications.Application{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, application); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an application; err=%v", err)
-		return err
-	}
-
-	client := applications.NewApplicationClient()
-	resourceURI, err := client.CreateApplication(ctx, application)
-	if err != nil {
-		vc.Logger.Errorf("unable to create the application; err=%v, application=%+v", err, application)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
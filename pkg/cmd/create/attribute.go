@@ -1,15 +1,14 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -21,11 +20,12 @@ import (
 const (
 	attributeUsage         = `attribute [options]`
 	attributeMessagePrefix = "CreateAttribute"
-	attributeEntitlements  = "Manage attributes"
 	attributeResourceName  = "attribute"
 )
 
 var (
+	attributeEntitlements = config.Entitlements{config.EntitlementManageAttributes}
+
 	attributeShortDesc = cmdutil.TranslateShortDesc(attributeMessagePrefix, "Additional options to create an attribute.")
 
 	attributeLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(attributeMessagePrefix, `
@@ -105,7 +105,7 @@ func (o *attributeOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *attributeOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+attributeEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+attributeEntitlements.String())
 		return nil
 	}
 
@@ -122,7 +122,16 @@ func (o *attributeOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.GetCurrentAuth()
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"Attribute")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), attributeEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -131,13 +140,9 @@ func (o *attributeOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *attributeOptions) createAttribute(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// get the contents of the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -165,31 +170,3 @@ func (o *attributeOptions) createAttributeWithData(cmd *cobra.Command, data []by
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *attributeOptions) createAttributeFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// unmarshal to attribute
-	attribute := &directory.Attribute{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, attribute); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an attribute; err=%v", err)
-		return err
-	}
-
-	client := directory.NewAttributeClient()
-	resourceURI, err := client.CreateAttribute(ctx, attribute)
-	if err != nil {
-		vc.Logger.Errorf("unable to create the attribute; err=%v, attribute=%+v", err, attribute)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
@@ -7,6 +7,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -27,9 +28,45 @@ var (
 
 JSON or YAML formats are accepted and determined based on the file extension.
 
+A file can hold several resources, either as YAML documents separated by '---' or as an 'IBMVerifyList',
+such as the output of 'get'. If a directory is provided, the resources in all of its JSON and YAML files
+are read. Use '-R' to read the subdirectories as well.
+
+Secrets, such as 'clientSecret', 'client_secret', 'password' and the values of properties marked as
+'sensitive', can be references so that the file can be committed without them:
+
+  - 'env:NAME' reads the environment variable
+  - 'file:/path' reads the file
+  - 'exec:command args' runs the command and reads its output
+
+Files can be rendered as Go templates before they are read, so that the same files can be used for
+several tenants. The values are read from the files given with '--values' and set with '--set', and
+are available as '{{ .Values.name }}'. Environment variables are available as '{{ .Env.NAME }}'.
+Referring to a value that is not defined is an error. The files are only rendered when '--values',
+'--set' or '--template' is used.
+
+Resources in other files can be included and patched using overlays, so that a base definition can be
+shared by several tenants. For more information:
+
+  verifyctl build -h
+
+Use '--dry-run' to preview the changes without making them:
+
+  - 'client' prints the requests that would create the resources, including their payload, instead of
+    sending them. The tenant is still read to look up the resources that others refer to.
+  - 'server' checks the data against the schema of each resource, as 'verifyctl validate' does, and
+    reads the tenant to check that none of the resources exist already. Verify does not offer
+    validation-only endpoints for these resources, so the data itself is not checked by the tenant.
+
+The commands of the resource types, such as 'verifyctl create attribute', support '--dry-run' as well.
+
 An empty resource file can be generated using:
 
   verifyctl create [resource-type] --boilerplate
+
+The properties of the resource are described by:
+
+  verifyctl explain [resource-type]
 		
 Resources managed on Verify require specific entitlements, so ensure that the application or API client used
 with the 'auth' command is configured with the appropriate entitlements.
@@ -44,7 +81,16 @@ Certain resources may offer additional options and can be determined using:
 
 	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
 		# Create an application
-		verifyctl create -f=./app-1098012.json`))
+		verifyctl create -f=./app-1098012.json
+
+		# Create the resources in a directory and its subdirectories
+		verifyctl create -f=./resources -R
+
+		# Create an application using the values for the production tenant
+		verifyctl create -f=./app.yaml --values=./values-prod.yaml --set=app.host=login.example.com
+
+		# Print the requests that would create the resources in a directory
+		verifyctl create -f=./resources --dry-run=client`))
 
 	// # Create and get an attribute
 	// verifyctl create -f=./attribute.yml -o=yaml
@@ -56,6 +102,7 @@ type options struct {
 	entitlements bool
 	boilerplate  bool
 	file         string
+	recursive    bool
 	//output       string
 
 	config *config.CLIConfig
@@ -105,10 +152,15 @@ func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string)
 func (o *options) addCommonFlags(cmd *cobra.Command, resourceName string) {
 	cmd.Flags().BoolVar(&o.entitlements, "entitlements", o.entitlements, i18n.Translate("List the entitlements that can be configured to grant access to the resource. This is useful to know what to configure on the application or API client used to generate the login token. When this flag is used, the others are ignored."))
 	cmd.Flags().BoolVar(&o.boilerplate, "boilerplate", o.boilerplate, i18n.TranslateWithArgs("Generate an empty %s file. This will be in YAML format.", resourceName))
+	resource.AddTemplateFlags(cmd)
+	dryrun.AddFlag(cmd)
 }
 
 func (o *options) AddFlags(cmd *cobra.Command) {
-	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file that contains the input data. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml."))
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file or directory that contains the input data. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml. Use '-' to read from stdin."))
+	cmd.Flags().BoolVarP(&o.recursive, "recursive", "R", o.recursive, i18n.Translate("Read the files in the subdirectories as well when the input is a directory."))
+	resource.AddTemplateFlags(cmd)
+	dryrun.AddFlag(cmd)
 	//cmd.Flags().StringVarP(&o.output, "output", "o", "", i18n.Translate("Fetches the newly created resource in the indicated format. The values supported are 'json' , 'yaml' and 'raw'. Default: 'yaml'."))
 }
 
@@ -122,83 +174,127 @@ func (o *options) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *options) Run(cmd *cobra.Command, args []string) error {
 	if len(o.file) == 0 {
-		return errorsx.G11NError("'file' option is required")
+		return errorsx.G11NError("'file' option is required.")
 	}
 
-	// read the file
-	resourceObject, err := o.readFile(cmd)
+	mode, err := dryrun.Mode(cmd)
 	if err != nil {
 		return err
 	}
 
-	if len(resourceObject.Kind) == 0 {
-		return errorsx.G11NError("No 'kind' defined. Resource type cannot be identified.")
+	resourceObjects, err := resource.LoadObjects(cmd, o.file, o.recursive)
+	if err != nil {
+		return err
+	}
+
+	return createResources(cmd, o.config, mode, resourceObjects)
+}
+
+// createFileDryRun previews creating the resource in the file read by the commands
+// of the resource types, which only holds the data of the resource.
+func createFileDryRun(cmd *cobra.Command, config *config.CLIConfig, mode string, file string, kind string) error {
+	resourceObject, err := resource.LoadDataFile(cmd, file, kind)
+	if err != nil {
+		return err
 	}
 
-	_, err = o.config.SetAuthToContext(cmd.Context())
+	return createResources(cmd, config, mode, []*resource.ResourceObject{resourceObject})
+}
+
+// createResources creates the resources, or previews creating them in a dry run.
+func createResources(cmd *cobra.Command, config *config.CLIConfig, mode string, resourceObjects []*resource.ResourceObject) error {
+	auth, err := config.SetAuthToContext(cmd.Context())
 	if err != nil {
 		return err
 	}
 
-	switch resourceObject.Kind {
-	case resource.ResourceTypePrefix + "Attribute":
-		options := &attributeOptions{}
-		err = options.createAttributeFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	// check all the resources before any of them are created
+	handlers := make([]*resource.KindHandler, 0, len(resourceObjects))
+	for _, resourceObject := range resourceObjects {
+		if len(resourceObject.Kind) == 0 {
+			return errorsx.G11NError("No 'kind' defined in '%s'. Resource type cannot be identified.", resourceObject.Source())
+		}
+
+		handler, err := resource.GetKindHandler(resourceObject.Kind)
+		if err != nil || !handler.Creatable() {
+			return errorsx.G11NError("The kind '%s' in '%s' is not supported.", resourceObject.Kind, resourceObject.Source())
+		}
 
-	case resource.ResourceTypePrefix + "User":
-		options := &userOptions{}
-		err = options.createUserFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+		if err := auth.CheckEntitlements(cmd.Context(), handler.Entitlements); err != nil {
+			return err
+		}
 
-	case resource.ResourceTypePrefix + "Group":
-		options := &groupOptions{}
-		err = options.createGroupFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+		handlers = append(handlers, handler)
+	}
 
-	case resource.ResourceTypePrefix + "AccessPolicy":
-		options := &accessPolicyOptions{}
-		err = options.createAccessPolicyFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	if mode == dryrun.Client {
+		cmd.SetContext(dryrun.WithClient(cmd.Context(), cmd.OutOrStdout()))
+	}
 
-	case resource.ResourceTypePrefix + "IdentitySource":
-		options := &identitySourceOptions{}
-		err = options.createIdentitySourceFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	for i, resourceObject := range resourceObjects {
+		handler := handlers[i]
 
-	case resource.ResourceTypePrefix + "APIClient":
-		options := &apiClientOptions{}
-		err = options.createAPIClientFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+		var err error
+		if mode == dryrun.Server {
+			err = checkResource(cmd, handler, resourceObject)
+		} else {
+			err = createResource(cmd, mode, handler, resourceObject)
+		}
 
-	case resource.ResourceTypePrefix + "Applications":
-		options := &applicationOptions{}
-		err = options.createApplicationFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+		if err != nil {
+			if len(resourceObjects) > 1 {
+				return errorsx.G11NError("Unable to create the '%s' resource in '%s'; err=%v", handler.Kind, resourceObject.Source(), err)
+			}
 
-	case resource.ResourceTypePrefix + "IdentityAgent":
-		options := &identityAgentOptions{}
-		err = options.createIdentityAgentFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+			return err
+		}
+	}
 
-	case resource.ResourceTypePrefix + "PasswordPolicy":
-		options := &passwordPolicyOptions{}
-		err = options.createPasswordPolicyFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	return nil
+}
+
+// checkResource checks the data of the resource and that it does not exist on the
+// tenant.
+func checkResource(cmd *cobra.Command, handler *resource.KindHandler, resourceObject *resource.ResourceObject) error {
+	ctx := cmd.Context()
+	vc := contextx.GetVerifyContext(ctx)
 
-	case resource.ResourceTypePrefix + "PersonalCert":
-		options := &personalCertOptions{}
-		err = options.createPersonalCertFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	if err := handler.Validate(ctx, resourceObject); err != nil {
+		return err
+	}
 
-	case resource.ResourceTypePrefix + "SignerCert":
-		options := &signerCertOptions{}
-		err = options.createSignerCertFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	current, err := handler.Find(ctx, resourceObject)
+	if err != nil {
+		vc.Logger.Errorf("unable to look up the resource; kind=%s, err=%v", handler.Kind, err)
+		return err
+	}
 
+	name := handler.Name(resourceObject)
+	if current != nil {
+		return errorsx.G11NError("%s '%s' already exists.", handler.Kind, name)
 	}
 
-	return err
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' is valid and does not exist", handler.Kind, name)+dryrun.Suffix(dryrun.Server))
+	return nil
 }
 
-func (o *options) readFile(cmd *cobra.Command) (*resource.ResourceObject, error) {
+// createResource creates the resource. In a client dry run, the requests are
+// printed instead, so the URI returned is not that of a resource.
+func createResource(cmd *cobra.Command, mode string, handler *resource.KindHandler, resourceObject *resource.ResourceObject) error {
 	ctx := cmd.Context()
 	vc := contextx.GetVerifyContext(ctx)
 
-	resourceObject := &resource.ResourceObject{}
-	if err := resourceObject.LoadFromFile(cmd, o.file, ""); err != nil {
-		vc.Logger.Errorf("unable to read file contents into resource object; err=%v", err)
-		return nil, err
+	resourceURI, err := handler.Create(ctx, resourceObject)
+	if err != nil {
+		vc.Logger.Errorf("unable to create the resource; kind=%s, err=%v", handler.Kind, err)
+		return err
 	}
 
-	return resourceObject, nil
+	if mode == dryrun.Client {
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' created", handler.Kind, handler.Name(resourceObject))+dryrun.Suffix(mode))
+		return nil
+	}
+
+	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
+	return nil
 }
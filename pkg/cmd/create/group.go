@@ -1,9 +1,7 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
@@ -11,6 +9,7 @@ import (
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -21,11 +20,12 @@ import (
 const (
 	groupUsage         = "group [options]"
 	groupMessagePrefix = "CreateGroup"
-	groupEntitlements  = "Manage groups"
 	groupResourceName  = "group"
 )
 
 var (
+	groupEntitlements = config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups}
+
 	groupShortDesc = cmdutil.TranslateShortDesc(groupMessagePrefix, "Additional options to create a group.")
 
 	groupLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(groupMessagePrefix, `
@@ -105,7 +105,7 @@ func (o *groupOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *groupOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+groupEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+groupEntitlements.String())
 		return nil
 	}
 
@@ -120,7 +120,16 @@ func (o *groupOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"Group")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), groupEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -129,13 +138,9 @@ func (o *groupOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *groupOptions) createGroup(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// get the contents of the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("Unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -163,31 +168,3 @@ func (o *groupOptions) createGroupWithData(cmd *cobra.Command, data []byte) erro
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *groupOptions) createGroupFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// unmarshal to group
-	group := &directory.Group{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("Failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, group); err != nil {
-		vc.Logger.Errorf("Unable to unmarshal to an group; err=%v", err)
-		return err
-	}
-
-	client := directory.NewGroupClient()
-	resourceURI, err := client.CreateGroup(ctx, group)
-	if err != nil {
-		vc.Logger.Errorf("Unable to create the group; err=%v, group=%+v", err, group)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
@@ -1,14 +1,13 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/integrations"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"gopkg.in/yaml.v3"
 
@@ -20,11 +19,12 @@ import (
 const (
 	identityAgentUsage         = "identityagent [options]"
 	identityAgentMessagePrefix = "CreateIdentityAgent"
-	identityAgentEntitlements  = "Manage Identity Agents"
 	identityAgentResourceName  = "identityagent"
 )
 
 var (
+	identityAgentEntitlements = config.Entitlements{config.EntitlementManageExternalAgents}
+
 	identityAgentShortDesc = cmdutil.TranslateShortDesc(identityAgentMessagePrefix, "Options to create an Identity Agent.")
 
 	identityAgentLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(identityAgentMessagePrefix, `
@@ -105,7 +105,7 @@ func (o *identityAgentOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *identityAgentOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+identityAgentEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+identityAgentEntitlements.String())
 		return nil
 	}
 
@@ -124,7 +124,16 @@ func (o *identityAgentOptions) Run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	_, err := o.config.GetCurrentAuth()
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"IdentityAgent")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), identityAgentEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -133,12 +142,8 @@ func (o *identityAgentOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *identityAgentOptions) createIdentityAgent(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -165,33 +170,3 @@ func (o *identityAgentOptions) createIdentityAgentWithData(cmd *cobra.Command, d
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *identityAgentOptions) createIdentityAgentFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// Convert map data to JSON
-	identityAgentConfig := &integrations.IdentityAgentConfig{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal data; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, identityAgentConfig); err != nil {
-		vc.Logger.Errorf("unable to unmarshal data to Identoty Agent; err=%v", err)
-		return err
-	}
-
-	// Create Identity Agent
-	client := integrations.NewIdentityAgentClient()
-	resourceURI, err := client.CreateIdentityAgent(ctx, identityAgentConfig)
-	if err != nil {
-		vc.Logger.Errorf("failed to create Identity Agent; err=%v", err)
-		return err
-	}
-
-	// Directly return the created resource URI
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
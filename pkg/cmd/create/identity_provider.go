@@ -1,9 +1,7 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/authentication"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
@@ -11,6 +9,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -21,11 +20,12 @@ import (
 const (
 	identitySourceUsage         = "identitysource [options]"
 	identitySourceMessagePrefix = "CreateIdentitySource"
-	identitySourceEntitlements  = "Manage identitySources"
 	identitySourceResourceName  = "identitysource"
 )
 
 var (
+	identitySourceEntitlements = config.Entitlements{config.EntitlementManageIdentitySources}
+
 	identitySourceShortDesc = cmdutil.TranslateShortDesc(identitySourceMessagePrefix, "Additional options to create a identitySource.")
 
 	identitySourceLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(identitySourceMessagePrefix, `
@@ -108,7 +108,7 @@ func (o *identitySourceOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *identitySourceOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+identitySourceEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+identitySourceEntitlements.String())
 		return nil
 	}
 
@@ -123,7 +123,16 @@ func (o *identitySourceOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"IdentitySource")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), identitySourceEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -132,13 +141,9 @@ func (o *identitySourceOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *identitySourceOptions) createIdentitySource(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// get the contents of the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -164,30 +169,3 @@ func (o *identitySourceOptions) createIdentitySourceWithData(cmd *cobra.Command,
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *identitySourceOptions) createIdentitySourceFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	identitySource := &authentication.IdentitySource{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, identitySource); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an Identity Source err=%v", err)
-		return err
-	}
-
-	client := authentication.NewIdentitySourceClient()
-	resourceURI, err := client.CreateIdentitySource(ctx, identitySource)
-	if err != nil {
-		vc.Logger.Errorf("unable to create the Identity Source err=%v, identitySource=%+v", err, identitySource)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
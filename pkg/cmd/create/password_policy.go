@@ -1,9 +1,7 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
@@ -12,6 +10,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -20,11 +19,12 @@ import (
 const (
 	passwordPolicyUsage         = `passwordpolicy [options]`
 	passwordPolicyMessagePrefix = "CreatePasswordPolicy"
-	passwordPolicyEntitlements  = "Manage password policies"
 	passwordPolicyResourceName  = "passwordpolicy"
 )
 
 var (
+	passwordPolicyEntitlements = config.Entitlements{config.EntitlementManagePwdPolicy}
+
 	passwordPolicyShortDesc = cmdutil.TranslateShortDesc(
 		passwordPolicyMessagePrefix,
 		"Additional options to create a password policy.",
@@ -111,7 +111,7 @@ func (o *passwordPolicyOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *passwordPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+passwordPolicyEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+passwordPolicyEntitlements.String())
 		return nil
 	}
 
@@ -126,7 +126,16 @@ func (o *passwordPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"PasswordPolicy")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), passwordPolicyEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -135,12 +144,8 @@ func (o *passwordPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *passwordPolicyOptions) createPasswordPolicy(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -166,30 +171,3 @@ func (o *passwordPolicyOptions) createPasswordPolicyWithData(cmd *cobra.Command,
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *passwordPolicyOptions) createPasswordPolicyFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	passwordPolicy := &security.PasswordPolicy{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map into json; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, passwordPolicy); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an passwordPolicy; err=%v", err)
-		return err
-	}
-
-	client := security.NewPasswordPolicyClient()
-	resourceURI, err := client.CreatePasswordPolicy(ctx, passwordPolicy)
-	if err != nil {
-		vc.Logger.Errorf("unable to create the password policy; err=%v, passwordPolicy=%+v", err, passwordPolicy)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
@@ -1,9 +1,7 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
@@ -12,6 +10,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -20,11 +19,12 @@ import (
 const (
 	signerCertUsage         = `signerCert [options]`
 	signerCertMessagePrefix = "CreatesignerCert"
-	signerCertEntitlements  = "Manage signer certificates"
 	signerCertResourceName  = "signerCert"
 )
 
 var (
+	signerCertEntitlements = config.Entitlements{config.EntitlementManageCerts}
+
 	signerCertShortDesc = cmdutil.TranslateShortDesc(
 		signerCertMessagePrefix,
 		"Create a signer certificate with specified options.",
@@ -108,7 +108,7 @@ func (o *signerCertOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *signerCertOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+signerCertEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+signerCertEntitlements.String())
 		return nil
 	}
 
@@ -123,7 +123,16 @@ func (o *signerCertOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"SignerCert")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), signerCertEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -132,12 +141,8 @@ func (o *signerCertOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *signerCertOptions) createSignerCert(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -163,30 +168,3 @@ func (o *signerCertOptions) createSignerCertWithData(cmd *cobra.Command, data []
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *signerCertOptions) createSignerCertFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	signerCert := &security.SignerCert{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map into json; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, signerCert); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an signerCert; err=%v", err)
-		return err
-	}
-
-	client := security.NewSignerCertClient()
-	resourceURI, err := client.CreateSignerCert(ctx, signerCert)
-	if err != nil {
-		vc.Logger.Errorf("unable to create the signer certificate; err=%v, signerCert=%+v", err, signerCert)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
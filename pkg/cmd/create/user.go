@@ -1,9 +1,7 @@
 package create
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
@@ -11,6 +9,7 @@ import (
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -21,11 +20,12 @@ import (
 const (
 	userUsage         = "user [options]"
 	userMessagePrefix = "CreateUser"
-	userEntitlements  = "Manage users"
 	userResourceName  = "user"
 )
 
 var (
+	userEntitlements = config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups}
+
 	userShortDesc = cmdutil.TranslateShortDesc(userMessagePrefix, "Additional options to create a user.")
 
 	userLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(userMessagePrefix, `
@@ -108,7 +108,7 @@ func (o *userOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *userOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+userEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+userEntitlements.String())
 		return nil
 	}
 
@@ -123,7 +123,16 @@ func (o *userOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return createFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"User")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), userEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -132,13 +141,9 @@ func (o *userOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *userOptions) createUser(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// get the contents of the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("Unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -166,31 +171,3 @@ func (o *userOptions) createUserWithData(cmd *cobra.Command, data []byte) error
 	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
 	return nil
 }
-
-func (o *userOptions) createUserFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// unmarshal to user
-	user := &directory.User{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, user); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an user; err=%v", err)
-		return err
-	}
-
-	client := directory.NewUserClient()
-	resourceURI, err := client.CreateUser(ctx, user)
-	if err != nil {
-		vc.Logger.Errorf("unable to create the user; err=%v, user=%+v", err, user)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource created: "+resourceURI)
-	return nil
-}
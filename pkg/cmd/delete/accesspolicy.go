@@ -6,8 +6,10 @@ import (
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +17,12 @@ import (
 const (
 	accessPoliciesUsage         = `accesspolicy [flags]`
 	accessPoliciesMessagePrefix = "DeleteAccessPolicy"
-	accessPoliciesEntitlements  = "Manage accessPolicies"
 	accessPolicyResourceName    = "accesspolicy"
 )
 
 var (
+	accessPoliciesEntitlements = config.Entitlements{config.EntitlementManageAccessPolicies}
+
 	accessPoliciesLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(accessPoliciesMessagePrefix, `
 		Delete Verify accessPolicy based on accessPolicyID.
 		
@@ -92,11 +95,20 @@ func (o *accessPoliciesOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *accessPoliciesOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+accessPoliciesEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+accessPoliciesEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"AccessPolicy", o.accessPolicyID, "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), accessPoliciesEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -16,11 +18,12 @@ import (
 const (
 	apiclientUsage         = `apiclient [flags]`
 	apiclientMessagePrefix = "DeleteApiclient"
-	apiclientEntitlements  = "Manage apiclients"
 	apiclientResourceName  = "apiclient"
 )
 
 var (
+	apiclientEntitlements = config.Entitlements{config.EntitlementManageAPIClients}
+
 	apiclientLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(apiclientMessagePrefix, `
 		Delete API client based on clientName.
 		
@@ -96,11 +99,20 @@ func (o *apiclientsOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *apiclientsOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+apiclientEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+apiclientEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"APIClient", o.id, "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), apiclientEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -6,8 +6,10 @@ import (
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/applications"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +17,12 @@ import (
 const (
 	applicationUsage         = "application [options]"
 	applicationMessagePrefix = "DeleteApplication"
-	applicationEntitlements  = "Manage applications"
 	applicationResourceName  = "application"
 )
 
 var (
+	applicationEntitlements = config.Entitlements{config.EntitlementManageAppAccessAdmin}
+
 	applicationLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(applicationMessagePrefix, `
 		Delete Verify Application based on ApplicationID.
 		
@@ -92,11 +95,20 @@ func (o *applicationsOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *applicationsOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+applicationEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+applicationEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"Application", o.applicationID, "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), applicationEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -6,8 +6,10 @@ import (
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +17,12 @@ import (
 const (
 	attributeUsage         = "attribute [options]"
 	attributeMessagePrefix = "DeleteAttribute"
-	attributeEntitlements  = "Manage attributes"
 	attributeResourceName  = "attribute"
 )
 
 var (
+	attributeEntitlements = config.Entitlements{config.EntitlementManageAttributes}
+
 	attributeLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(attributeMessagePrefix, `
         Delete an attribute in IBM Security Verify based on attribute ID.
         Resources managed on Verify have specific entitlements, so ensure that the
@@ -86,10 +89,19 @@ func (o *attributeOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *attributeOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+" "+attributeEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+attributeEntitlements.String())
 		return nil
 	}
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"Attribute", o.id, "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), attributeEntitlements...)
 	if err != nil {
 		return err
 	}
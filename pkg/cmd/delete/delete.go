@@ -4,14 +4,19 @@ import (
 	"io"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 )
 
 const (
-	usage         = "delete [resource-type] --name=name"
+	usage         = "delete [resource-type] --name=name | -f=FILENAME"
 	messagePrefix = "Delete"
 )
 
@@ -21,6 +26,19 @@ var (
 	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
 		Delete a Verify resource.
 
+Resources can also be deleted using the files used to create them. The resources are looked up on
+the tenant using the 'UID' in the metadata or their name. A file can hold several resources, either as
+YAML documents separated by '---' or as an 'IBMVerifyList', and a directory can be used to read all the
+JSON and YAML files in it. Use '-R' to read the subdirectories as well.
+
+Use '--dry-run' to preview the changes without making them, with '-f' or with the commands of the
+resource types, such as 'verifyctl delete user':
+
+  - 'client' prints the requests that would delete the resources instead of sending them. The tenant
+    is still read to look up the resources.
+  - 'server' reads the tenant to report the resources that would be deleted. Verify does not offer
+    validation-only endpoints for these resources.
+
 Resources managed on Verify require specific entitlements, so ensure that the application or API client used
 with the 'auth' command is configured with the appropriate entitlements.
 
@@ -34,7 +52,13 @@ Certain resources may offer additional options and can be determined using:
 
 	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
 		# Delete an user
-		verifyctl delete [resource-type] --name=userName`))
+		verifyctl delete [resource-type] --name=userName
+
+		# Delete the resources in a file
+		verifyctl delete -f=./resources.yaml
+
+		# Print the requests that would delete the resources in a file
+		verifyctl delete -f=./resources.yaml --dry-run=client`))
 
 	entitlementsMessage = i18n.Translate("Choose any of the following entitlements to configure your application or API client:\n")
 )
@@ -42,6 +66,8 @@ Certain resources may offer additional options and can be determined using:
 type options struct {
 	entitlements bool
 	name         string
+	file         string
+	recursive    bool
 	config       *config.CLIConfig
 }
 
@@ -68,6 +94,8 @@ func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string)
 	cmd.SetErr(streams)
 	cmd.SetIn(streams)
 
+	o.AddFlags(cmd)
+
 	// add sub commands
 	cmd.AddCommand(NewUserCommand(config, streams))
 	cmd.AddCommand(NewGroupCommand(config, streams))
@@ -86,6 +114,14 @@ func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string)
 
 func (o *options) addCommonFlags(cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.entitlements, "entitlements", o.entitlements, i18n.Translate("List the entitlements that can be configured to grant access to the resource. This is useful to know what to configure on the application or API client used to generate the login token. When this flag is used, the others are ignored."))
+	dryrun.AddFlag(cmd)
+}
+
+func (o *options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file or directory that contains the resources to delete. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml. Use '-' to read from stdin."))
+	cmd.Flags().BoolVarP(&o.recursive, "recursive", "R", o.recursive, i18n.Translate("Read the files in the subdirectories as well when the input is a directory."))
+	resource.AddTemplateFlags(cmd)
+	dryrun.AddFlag(cmd)
 }
 
 func (o *options) Complete(cmd *cobra.Command, args []string) error {
@@ -93,9 +129,108 @@ func (o *options) Complete(cmd *cobra.Command, args []string) error {
 }
 
 func (o *options) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.file) == 0 {
+		return errorsx.G11NError("'file' option is required.")
+	}
+
 	return nil
 }
 
 func (o *options) Run(cmd *cobra.Command, args []string) error {
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	resourceObjects, err := resource.LoadObjects(cmd, o.file, o.recursive)
+	if err != nil {
+		return err
+	}
+
+	return deleteResources(cmd, o.config, mode, resourceObjects)
+}
+
+// deleteDryRun previews deleting the resource identified by the flags of the commands
+// of the resource types, using its ID or its name.
+func deleteDryRun(cmd *cobra.Command, config *config.CLIConfig, mode string, kind string, id string, name string) error {
+	return deleteResources(cmd, config, mode, []*resource.ResourceObject{
+		{
+			Kind: kind,
+			Metadata: &resource.ResourceObjectMetadata{
+				UID:  id,
+				Name: name,
+			},
+		},
+	})
+}
+
+// deleteResources deletes the resources, or previews deleting them in a dry run.
+func deleteResources(cmd *cobra.Command, config *config.CLIConfig, mode string, resourceObjects []*resource.ResourceObject) error {
+	handlers := make([]*resource.KindHandler, 0, len(resourceObjects))
+	for _, resourceObject := range resourceObjects {
+		handler, err := resource.GetKindHandler(resourceObject.Kind)
+		if err != nil {
+			return errorsx.G11NError("Unable to delete the resource in '%s'; err=%v", resourceObject.Source(), err)
+		}
+
+		handlers = append(handlers, handler)
+	}
+
+	auth, err := config.SetAuthToContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	// check all the resources before any of them are deleted
+	for _, handler := range handlers {
+		if err := auth.CheckEntitlements(cmd.Context(), handler.Entitlements); err != nil {
+			return err
+		}
+	}
+
+	if mode == dryrun.Client {
+		cmd.SetContext(dryrun.WithClient(cmd.Context(), cmd.OutOrStdout()))
+	}
+
+	ctx := cmd.Context()
+	vc := contextx.GetVerifyContext(ctx)
+
+	// resources are deleted in the reverse order, so that the ones that depend on
+	// others are deleted first
+	for i := len(resourceObjects) - 1; i >= 0; i-- {
+		resourceObject, handler := resourceObjects[i], handlers[i]
+		current, err := handler.Find(ctx, resourceObject)
+		if err != nil {
+			vc.Logger.Errorf("unable to look up the resource; kind=%s, err=%v", handler.Kind, err)
+			return err
+		}
+
+		name := handler.Name(resourceObject)
+		if len(name) == 0 && resourceObject.Metadata != nil {
+			name = resourceObject.Metadata.UID
+		}
+
+		if current == nil {
+			cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' not found", handler.Kind, name))
+			continue
+		}
+
+		if len(current.Metadata.Name) > 0 {
+			name = current.Metadata.Name
+		}
+
+		if mode == dryrun.Server {
+			cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' exists", handler.Kind, name)+dryrun.Suffix(mode))
+			continue
+		}
+
+		if err := handler.Delete(ctx, current); err != nil {
+			vc.Logger.Errorf("unable to delete the resource; kind=%s, id=%s, err=%v", handler.Kind, current.Metadata.UID, err)
+			return err
+		}
+
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' deleted", handler.Kind, name)+dryrun.Suffix(mode))
+	}
+
 	return nil
 }
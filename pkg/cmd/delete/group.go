@@ -6,8 +6,10 @@ import (
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +17,12 @@ import (
 const (
 	groupsUsage         = `group [flags]`
 	groupsMessagePrefix = "DeleteGroup"
-	groupsEntitlements  = "Manage groups"
 	groupResourceName   = "group"
 )
 
 var (
+	groupsEntitlements = config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups}
+
 	groupsLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(groupsMessagePrefix, `
 		Delete Verify group based on name.
 		
@@ -92,11 +95,20 @@ func (o *groupsOptions) Valnameate(cmd *cobra.Command, args []string) error {
 
 func (o *groupsOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+groupsEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+groupsEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"Group", "", o.name)
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), groupsEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/integrations"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -16,11 +18,12 @@ import (
 const (
 	identityAgentUsage         = `identityagent [flags]`
 	identityAgentMessagePrefix = "DeleteIdentityAgent"
-	identityAgentEntitlements  = "Manage identityAgents"
 	identityAgentResourceName  = "identityagent"
 )
 
 var (
+	identityAgentEntitlements = config.Entitlements{config.EntitlementManageExternalAgents}
+
 	identityAgentLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(identityAgentMessagePrefix, `
 		Delete Identity Agent based on identityAgentID.
 		
@@ -93,11 +96,20 @@ func (o *identityAgentsOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *identityAgentsOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+identityAgentEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+identityAgentEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"IdentityAgent", o.identityAgentID, "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), identityAgentEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -6,8 +6,10 @@ import (
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/authentication"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +17,12 @@ import (
 const (
 	identitySourcesUsage         = `identitysource [flags]`
 	identitySourcesMessagePrefix = "DeleteIdentitySource"
-	identitySourcesEntitlements  = "Manage identitySources"
 	identitySourceResourceName   = "identitysource"
 )
 
 var (
+	identitySourcesEntitlements = config.Entitlements{config.EntitlementManageIdentitySources}
+
 	identitySourcesLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(identitySourcesMessagePrefix, `
 		Delete Verify identitySource based on identitySourceID.
 		
@@ -92,11 +95,20 @@ func (o *identitySourcesOptions) Validate(cmd *cobra.Command, args []string) err
 
 func (o *identitySourcesOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+identitySourcesEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+identitySourcesEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"IdentitySource", o.identitySourceID, "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), identitySourcesEntitlements...)
 	if err != nil {
 		return err
 	}
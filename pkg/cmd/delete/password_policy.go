@@ -6,8 +6,10 @@ import (
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +17,12 @@ import (
 const (
 	passwordPolicyUsage         = "passwordpolicy [options]"
 	passwordPolicyMessagePrefix = "DeletePasswordPolicy"
-	passwordPolicyEntitlements  = "Manage password policies"
 	passwordPolicyResourceName  = "passwordpolicy"
 )
 
 var (
+	passwordPolicyEntitlements = config.Entitlements{config.EntitlementManagePwdPolicy}
+
 	passwordPolicyLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(passwordPolicyMessagePrefix, `
 Delete a password policy in IBM Security Verify based on policy passwordPolicyID.
 Resources managed on Verify have specific entitlements, so ensure that the application or API client used
@@ -89,11 +92,20 @@ func (o *passwordPolicyOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *passwordPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+passwordPolicyEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+passwordPolicyEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"PasswordPolicy", o.passwordPolicyID, "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), passwordPolicyEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -6,8 +6,10 @@ import (
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +17,12 @@ import (
 const (
 	personalCertUsage         = `personalCert [options]`
 	personalCertMessagePrefix = "DeletePersonalCert"
-	personalCertEntitlements  = "Manage Personal Certs"
 	personalCertResourceName  = "personalCert"
 )
 
 var (
+	personalCertEntitlements = config.Entitlements{config.EntitlementManageCerts}
+
 	personalCertLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(personalCertMessagePrefix, `
 		Delete a personal certificate in IBM Security Verify based on label.
 		Resources managed on Verify have specific entitlements, so ensure that the application or API client used with the 'auth' command is configured with the appropriate entitlements.
@@ -82,11 +85,20 @@ func (o *personalCertOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *personalCertOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+" "+personalCertEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+personalCertEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"PersonalCert", "", o.label)
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), personalCertEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -6,8 +6,10 @@ import (
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -15,11 +17,12 @@ import (
 const (
 	usersUsage         = `user [flags]`
 	usersMessagePrefix = "DeleteUser"
-	usersEntitlements  = "Manage users"
 	userResourceName   = "user"
 )
 
 var (
+	usersEntitlements = config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups}
+
 	usersLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(usersMessagePrefix, `
 		Delete Verify user based on username.
 		
@@ -92,11 +95,20 @@ func (o *usersOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *usersOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+usersEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+usersEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return deleteDryRun(cmd, o.config, mode, resource.ResourceTypePrefix+"User", "", o.name)
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), usersEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,208 @@
+package diff
+
+import (
+	"io"
+	"strings"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	diffutil "github.com/ibm-verify/verifyctl/pkg/util/diff"
+	"github.com/ibm-verify/verifyctl/pkg/util/secret"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	usage         = "diff -f=FILENAME [options]"
+	messagePrefix = "Diff"
+)
+
+var (
+	shortDesc = cmdutil.TranslateShortDesc(messagePrefix, "Compare Verify resources with the ones on the tenant.")
+
+	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
+		Compare Verify resources in a file or directory with the ones on the tenant.
+
+Each resource is looked up on the tenant in the same way as 'apply', using the 'UID' in the metadata
+or its name. The differences are printed in the unified diff format, where the lines starting with '-'
+are on the tenant and the lines starting with '+' are in the file. A resource that does not exist on
+the tenant is shown as added.
+
+Only the properties in the file are compared. Properties managed by the tenant, such as IDs,
+timestamps and URIs, are ignored, and so are secrets that the tenant does not return.
+
+Secret references, such as 'env:NAME' and 'exec:command', are not resolved, and the values of
+secrets, such as 'clientSecret' and 'password', are redacted on both sides. Changes to secrets are
+therefore not shown.
+
+The command exits with status 1 if any resource differs, so that it can be used to check changes
+before they are applied. Input files are read in the same way as 'apply'. For more information:
+
+  verifyctl apply -h`))
+
+	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
+		# Compare an application with the one on the tenant
+		verifyctl diff -f=./app-1098012.yaml
+
+		# Compare the resources in a directory and its subdirectories
+		verifyctl diff -f=./resources -R`))
+)
+
+type options struct {
+	file      string
+	recursive bool
+
+	config *config.CLIConfig
+}
+
+func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &options{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   usage,
+		Short:                 shortDesc,
+		Long:                  longDesc,
+		Example:               examples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file or directory that contains the input data. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml. Use '-' to read from stdin."))
+	cmd.Flags().BoolVarP(&o.recursive, "recursive", "R", o.recursive, i18n.Translate("Read the files in the subdirectories as well when the input is a directory."))
+	resource.AddTemplateFlags(cmd)
+}
+
+func (o *options) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *options) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.file) == 0 {
+		return errorsx.G11NError("'file' option is required.")
+	}
+
+	return nil
+}
+
+func (o *options) Run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	vc := contextx.GetVerifyContext(ctx)
+
+	// the secrets are not resolved, since they are not printed
+	resourceObjects, err := resource.BuildObjects(cmd, o.file, o.recursive)
+	if err != nil {
+		vc.Logger.Errorf("unable to read the resources; err=%v", err)
+		return err
+	}
+
+	handlers := make([]*resource.KindHandler, 0, len(resourceObjects))
+	for _, resourceObject := range resourceObjects {
+		handler, err := resource.GetKindHandler(resourceObject.Kind)
+		if err != nil {
+			return errorsx.G11NError("Unable to compare the resource in '%s'; err=%v", resourceObject.Source(), err)
+		}
+
+		handlers = append(handlers, handler)
+	}
+
+	auth, err := o.config.SetAuthToContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the resources are only read, so a read-only token is enough
+	for _, handler := range handlers {
+		if err := auth.CheckEntitlements(ctx, handler.ReadEntitlements); err != nil {
+			return err
+		}
+	}
+
+	differences := 0
+	for i, resourceObject := range resourceObjects {
+		handler := handlers[i]
+		changes, err := o.diff(cmd, handler, resourceObject)
+		if err != nil {
+			if len(resourceObjects) > 1 {
+				return errorsx.G11NError("Unable to compare the '%s' resource in '%s'; err=%v", handler.Kind, resourceObject.Source(), err)
+			}
+
+			return err
+		}
+
+		if len(changes) > 0 {
+			differences++
+			cmdutil.WriteString(cmd, strings.TrimSuffix(changes, "\n"))
+		}
+	}
+
+	if differences > 0 {
+		return errorsx.G11NError("%d of %d resources differ from the tenant.", differences, len(resourceObjects))
+	}
+
+	return nil
+}
+
+// diff returns the differences between the resource on the tenant and the one in
+// the file. It returns an empty string if they are the same.
+func (o *options) diff(cmd *cobra.Command, handler *resource.KindHandler, resourceObject *resource.ResourceObject) (string, error) {
+	ctx := cmd.Context()
+	vc := contextx.GetVerifyContext(ctx)
+
+	current, err := handler.Find(ctx, resourceObject)
+	if err != nil {
+		vc.Logger.Errorf("unable to look up the resource; kind=%s, err=%v", handler.Kind, err)
+		return "", err
+	}
+
+	desired, actual, err := handler.Compare(current, resourceObject)
+	if err != nil {
+		return "", err
+	}
+
+	to, err := toYAML(secret.Redact(desired))
+	if err != nil {
+		return "", err
+	}
+
+	from := ""
+	if current != nil {
+		if from, err = toYAML(secret.Redact(actual)); err != nil {
+			return "", err
+		}
+	}
+
+	name := handler.Kind + "/" + handler.Name(resourceObject)
+	return diffutil.Unified("tenant/"+name, "local/"+name, from, to), nil
+}
+
+func toYAML(v interface{}) (string, error) {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,311 @@
+package explain
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/schema"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	usage         = "explain RESOURCE[.FIELD...] [options]"
+	messagePrefix = "Explain"
+
+	// lineWidth is the width the descriptions are wrapped at
+	lineWidth = 100
+)
+
+var (
+	shortDesc = cmdutil.TranslateShortDesc(messagePrefix, "Describe the properties of a resource.")
+
+	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
+		Describe the properties of the data of a resource in a resource file.
+
+The resource is identified by its kind, with or without the 'IBMVerify' prefix and ignoring case,
+such as 'IBMVerifyApplication' or 'application'. Nested properties are identified by their path,
+separated by dots, such as 'application.providers.oidc'. The items of arrays and the values of maps
+are described when their property is used in the path.
+
+The name, type and description of each property is printed, with the values allowed if they are
+known. The properties are those of the types used to send the data to the tenant, so they can be
+checked offline using:
+
+  verifyctl validate -f=FILENAME
+
+The descriptions are read from the SDK and its copy of the Verify API specification. The properties
+of the password policies, personal certificates, signer certificates and sign-in options have none,
+nor do some nested properties, such as 'application.providers.oidc.properties.additionalConfig', so
+only their names and types are printed.
+
+Run the command without arguments to list the resources.`))
+
+	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
+		# List the resources
+		verifyctl explain
+
+		# Describe the properties of an application
+		verifyctl explain application
+
+		# Describe the OIDC properties of an application
+		verifyctl explain application.providers.oidc
+
+		# Describe all the nested properties of an attribute
+		verifyctl explain attribute --recursive`))
+)
+
+type options struct {
+	recursive bool
+
+	config *config.CLIConfig
+}
+
+func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &options{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   usage,
+		Short:                 shortDesc,
+		Long:                  longDesc,
+		Example:               examples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.recursive, "recursive", o.recursive, i18n.Translate("Print the names and types of all the nested properties."))
+}
+
+func (o *options) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *options) Validate(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		return errorsx.G11NError("Only one resource can be described.")
+	}
+
+	return nil
+}
+
+func (o *options) Run(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		o.listKinds(cmd)
+		return nil
+	}
+
+	path := strings.Split(args[0], ".")
+	kind, apiVersions, s, err := findKind(path[0])
+	if err != nil {
+		return err
+	}
+
+	for i, name := range path[1:] {
+		if s, err = property(s, name); err != nil {
+			return errorsx.G11NError("The property '%s' of '%s' does not exist.", strings.Join(path[1:i+2], "."), kind)
+		}
+	}
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "KIND:     %s\n", kind)
+	fmt.Fprintf(b, "VERSIONS: %s\n", strings.Join(apiVersions, ", "))
+	if len(path) > 1 {
+		fmt.Fprintf(b, "\nFIELD:    %s <%s>\n", strings.Join(path[1:], "."), typeName(s))
+	}
+
+	if len(s.Description) > 0 || len(s.Enum) > 0 {
+		fmt.Fprintf(b, "\nDESCRIPTION:\n")
+		writeDescription(b, s, "    ")
+	}
+
+	// describe the items of arrays and the values of maps
+	fields := s
+	for fields.Items != nil {
+		fields = fields.Items
+	}
+
+	if fields.Properties == nil && fields.AdditionalProperties != nil && fields.AdditionalProperties.Properties != nil {
+		fields = fields.AdditionalProperties
+	}
+
+	if len(fields.Properties) > 0 {
+		fmt.Fprintf(b, "\nFIELDS:\n")
+		if o.recursive {
+			writeTree(b, fields, "  ")
+		} else {
+			writeFields(b, fields)
+		}
+	}
+
+	cmdutil.WriteString(cmd, strings.TrimSuffix(b.String(), "\n"))
+	return nil
+}
+
+func (o *options) listKinds(cmd *cobra.Command) {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "RESOURCES:\n")
+	for _, h := range resource.KindHandlers() {
+		fmt.Fprintf(b, "  %s\n", h.Kind)
+	}
+
+	fmt.Fprintf(b, "  %s", resource.OverlayKind)
+	cmdutil.WriteString(cmd, b.String())
+}
+
+// findKind returns the kind identified by the name, the versions of the resource
+// files and the schema of the data.
+func findKind(name string) (string, []string, *schema.Schema, error) {
+	matches := func(kind string) bool {
+		short := strings.TrimPrefix(kind, resource.ResourceTypePrefix)
+		for _, n := range []string{name, strings.TrimSuffix(name, "s")} {
+			if strings.EqualFold(n, kind) || strings.EqualFold(n, short) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, h := range resource.KindHandlers() {
+		if matches(h.Kind) {
+			return h.Kind, h.APIVersions, h.Schema(), nil
+		}
+	}
+
+	if matches(resource.OverlayKind) {
+		return resource.OverlayKind, []string{"1.0"}, resource.OverlaySchema(), nil
+	}
+
+	return "", nil, nil, errorsx.G11NError("The resource '%s' is not supported. Run 'verifyctl explain' to list the resources.", name)
+}
+
+// property returns the schema of the property. The items of arrays and the values of
+// maps are used in place of the array or map.
+func property(s *schema.Schema, name string) (*schema.Schema, error) {
+	for s.Items != nil {
+		s = s.Items
+	}
+
+	if s.Properties == nil && s.AdditionalProperties != nil && s.AdditionalProperties.Properties != nil {
+		s = s.AdditionalProperties
+	}
+
+	if _, p := s.Property(name); p != nil && s.Properties != nil {
+		return p, nil
+	}
+
+	return nil, errorsx.G11NError("not found")
+}
+
+func writeFields(b *strings.Builder, s *schema.Schema) {
+	for _, name := range s.PropertyNames() {
+		p := s.Properties[name]
+		fmt.Fprintf(b, "  %s\t<%s>%s\n", name, typeName(p), requiredLabel(s, name))
+		if len(p.Description) > 0 || len(p.Enum) > 0 {
+			writeDescription(b, p, "    ")
+			b.WriteString("\n")
+		}
+	}
+}
+
+func writeTree(b *strings.Builder, s *schema.Schema, indent string) {
+	for _, name := range s.PropertyNames() {
+		p := s.Properties[name]
+		fmt.Fprintf(b, "%s%s\t<%s>%s\n", indent, name, typeName(p), requiredLabel(s, name))
+
+		nested := p
+		for nested.Items != nil {
+			nested = nested.Items
+		}
+
+		if nested.Properties == nil && nested.AdditionalProperties != nil {
+			nested = nested.AdditionalProperties
+		}
+
+		writeTree(b, nested, indent+"  ")
+	}
+}
+
+func writeDescription(b *strings.Builder, s *schema.Schema, indent string) {
+	if len(s.Description) > 0 {
+		b.WriteString(wrap(s.Description, indent))
+	}
+
+	if len(s.Enum) > 0 {
+		b.WriteString(wrap(i18n.TranslateWithArgs("Allowed values: %s", strings.Join(s.Enum, ", ")), indent))
+	}
+}
+
+func requiredLabel(s *schema.Schema, name string) string {
+	for _, r := range s.Required {
+		if r == name {
+			return " -required-"
+		}
+	}
+
+	return ""
+}
+
+// typeName returns the type of the value, such as 'string', '[]Object' or
+// 'map[string]string'.
+func typeName(s *schema.Schema) string {
+	switch s.Type {
+	case schema.TypeArray:
+		return "[]" + typeName(s.Items)
+	case schema.TypeObject:
+		if s.Properties != nil {
+			return "Object"
+		}
+
+		return "map[string]" + typeName(s.AdditionalProperties)
+	case "":
+		return "any"
+	}
+
+	return s.Type
+}
+
+// wrap breaks the text into lines that fit the line width.
+func wrap(text string, indent string) string {
+	b := &strings.Builder{}
+	line := indent
+	for _, word := range strings.Fields(text) {
+		if len(line) > len(indent) && len(line)+1+len(word) > lineWidth {
+			b.WriteString(line + "\n")
+			line = indent
+		}
+
+		if len(line) > len(indent) {
+			line += " "
+		}
+
+		line += word
+	}
+
+	b.WriteString(line + "\n")
+	return b.String()
+}
@@ -0,0 +1,269 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	usage         = "export --dir=DIRECTORY [options]"
+	messagePrefix = "Export"
+
+	// the exported files may hold secrets, such as client secrets
+	dirPerm  = 0o700
+	filePerm = 0o600
+)
+
+// layout is the directory used for each kind in an export. The kinds are in the
+// order they are imported, so that resources are created before the ones that
+// refer to them.
+var layout = []struct {
+	kind string
+	dir  string
+}{
+	{resource.ResourceTypePrefix + "Attribute", "attributes"},
+	{resource.ResourceTypePrefix + "SignerCert", "signercerts"},
+	{resource.ResourceTypePrefix + "PersonalCert", "personalcerts"},
+	{resource.ResourceTypePrefix + "PasswordPolicy", "passwordpolicies"},
+	{resource.ResourceTypePrefix + "IdentitySource", "identitysources"},
+	{resource.ResourceTypePrefix + "User", "users"},
+	{resource.ResourceTypePrefix + "Group", "groups"},
+	{resource.ResourceTypePrefix + "AccessPolicy", "accesspolicies"},
+	{resource.ResourceTypePrefix + "APIClient", "apiclients"},
+	{resource.ResourceTypePrefix + "Application", "applications"},
+	{resource.ResourceTypePrefix + "IdentityAgent", "identityagents"},
+	{resource.ResourceTypePrefix + "Theme", "themes"},
+}
+
+// unsafeFileChars matches the characters that are replaced in file names.
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+var (
+	shortDesc = cmdutil.TranslateShortDesc(messagePrefix, "Export the resources on the tenant to a directory.")
+
+	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
+		Export the resources on the tenant to a directory.
+
+Each resource is written to its own YAML file in a directory named after its kind, such as
+'attributes/email.yaml' or 'applications/My-App.yaml'. The files can be restored using 'import',
+or individually using 'apply'. Attributes, certificates, password policies, identity sources,
+users, groups, access policies, API clients, applications, identity agents and themes are exported.
+
+Properties managed by the tenant, such as IDs and timestamps, are removed from the data so that the
+resources can be imported into another tenant. The ID is kept in the metadata, so that the references
+to the resource, such as the access policy of an application, can be replaced with the ID of the
+resource with the same name when it is imported. The members of groups are identified by userName
+and are looked up on the tenant when the groups are imported. Themes hold their customized templates
+as a base64-encoded zip file.
+
+The files in the directories of each kind are replaced, so that resources deleted on the tenant are
+not restored. The files may hold secrets, such as client secrets, so they are only readable by the
+current user.
+
+Resources managed on Verify require specific entitlements to be read, so ensure that the application
+or API client used with the 'auth' command is configured with the entitlements listed in:
+
+  verifyctl get [resource-type] --entitlements`))
+
+	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
+		# Back up the tenant
+		verifyctl export --dir=./backup
+
+		# Restore the backup
+		verifyctl import --dir=./backup`))
+)
+
+type options struct {
+	dir string
+
+	config *config.CLIConfig
+}
+
+func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &options{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   usage,
+		Short:                 shortDesc,
+		Long:                  longDesc,
+		Example:               examples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.dir, "dir", o.dir, i18n.Translate("Path to the directory where the resources are written. It is created if it does not exist."))
+}
+
+func (o *options) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *options) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.dir) == 0 {
+		return errorsx.G11NError("'dir' flag is required.")
+	}
+
+	return nil
+}
+
+func (o *options) Run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	vc := contextx.GetVerifyContext(ctx)
+
+	handlers, err := layoutHandlers()
+	if err != nil {
+		return err
+	}
+
+	auth, err := o.config.SetAuthToContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// check all the kinds before anything is written
+	for _, handler := range handlers {
+		if err := auth.CheckEntitlements(ctx, handler.ReadEntitlements); err != nil {
+			return err
+		}
+	}
+
+	total := 0
+	for i, l := range layout {
+		handler := handlers[i]
+		objects, err := handler.Export(ctx)
+		if err != nil {
+			vc.Logger.Errorf("unable to list the resources; kind=%s, err=%v", handler.Kind, err)
+			return errorsx.G11NError("Unable to export the '%s' resources; err=%v", handler.Kind, err)
+		}
+
+		dir := filepath.Join(o.dir, l.dir)
+		if err := resetDir(dir); err != nil {
+			vc.Logger.Errorf("unable to prepare the directory; dir=%s, err=%v", dir, err)
+			return err
+		}
+
+		used := map[string]bool{}
+		for _, obj := range objects {
+			file := filepath.Join(dir, fileName(obj, used))
+			if err := writeObject(file, obj); err != nil {
+				vc.Logger.Errorf("unable to write the resource; filename=%s, err=%v", file, err)
+				return errorsx.G11NError("Unable to write '%s'; err=%v", file, err)
+			}
+		}
+
+		total += len(objects)
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s: %d", handler.Kind, len(objects)))
+	}
+
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("Exported %d resources to '%s'.", total, o.dir))
+	return nil
+}
+
+// layoutHandlers returns the handlers of the kinds in the layout.
+func layoutHandlers() ([]*resource.KindHandler, error) {
+	handlers := make([]*resource.KindHandler, 0, len(layout))
+	for _, l := range layout {
+		handler, err := resource.GetKindHandler(l.kind)
+		if err != nil {
+			return nil, err
+		}
+
+		handlers = append(handlers, handler)
+	}
+
+	return handlers, nil
+}
+
+// resetDir creates the directory or removes the resource files in it.
+func resetDir(dir string) error {
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fileName returns a unique file name based on the name of the resource.
+func fileName(obj *resource.ResourceObject, used map[string]bool) string {
+	name := obj.Metadata.Name
+	if len(name) == 0 {
+		name = obj.Metadata.UID
+	}
+
+	name = strings.Trim(unsafeFileChars.ReplaceAllString(name, "-"), "-.")
+	if len(name) == 0 {
+		name = "resource"
+	}
+
+	ret := name
+	for i := 2; used[strings.ToLower(ret)]; i++ {
+		ret = fmt.Sprintf("%s-%d", name, i)
+	}
+
+	// file systems may not be case sensitive
+	used[strings.ToLower(ret)] = true
+	return ret + ".yaml"
+}
+
+func writeObject(file string, obj *resource.ResourceObject) error {
+	b := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(obj); err != nil {
+		return err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, b.Bytes(), filePerm)
+}
@@ -0,0 +1,196 @@
+package export
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	importUsage         = "import --dir=DIRECTORY [options]"
+	importMessagePrefix = "Import"
+)
+
+var (
+	importLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(importMessagePrefix, `
+		Import the resources exported using 'export' into the tenant.
+
+The directories of each kind are read in an order where resources are created before the ones that
+refer to them, such as users before the groups they are members of. Each resource is then created or
+updated in the same way as 'apply', and the result is reported as 'created', 'updated' or 'unchanged'.
+Directories that do not exist are skipped.
+
+Resources are matched by name, so that a backup can be imported into another tenant. Resources refer to
+each other using IDs, such as the access policy, identity sources and attributes used by an application.
+These references are replaced with the IDs of the resources with the same names on the tenant, using
+the IDs and names recorded in the exported files. References to resources that are not in the directory,
+such as built-in ones, are not changed. A resource fails to be imported if a resource it refers to does
+not exist on the tenant.
+
+If a resource cannot be imported, the error is reported and the other resources are still imported.
+The command exits with status 1 if any of them failed.
+
+Themes cannot be created, so they are only imported if a theme with the same ID or name exists.`))
+
+	importExamples = templates.Examples(cmdutil.TranslateExamples(importMessagePrefix, `
+		# Restore a backup
+		verifyctl import --dir=./backup
+
+		# Copy the resources in a backup to another tenant
+		verifyctl import --dir=./backup --context=prod`))
+)
+
+type importOptions struct {
+	dir string
+
+	config *config.CLIConfig
+}
+
+func NewImportCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &importOptions{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   importUsage,
+		Short:                 cmdutil.TranslateShortDesc(importMessagePrefix, "Import the resources in a directory created by 'export'."),
+		Long:                  importLongDesc,
+		Example:               importExamples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *importOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.dir, "dir", o.dir, i18n.Translate("Path to the directory created by 'export'."))
+}
+
+func (o *importOptions) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *importOptions) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.dir) == 0 {
+		return errorsx.G11NError("'dir' flag is required.")
+	}
+
+	if info, err := os.Stat(o.dir); err != nil || !info.IsDir() {
+		return errorsx.G11NError("The directory '%s' does not exist.", o.dir)
+	}
+
+	return nil
+}
+
+func (o *importOptions) Run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	vc := contextx.GetVerifyContext(ctx)
+
+	resourceObjects := []*resource.ResourceObject{}
+	for _, l := range layout {
+		dir := filepath.Join(o.dir, l.dir)
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+
+		objects, err := resource.LoadObjects(cmd, dir, false)
+		if err != nil {
+			vc.Logger.Errorf("unable to read the resources; dir=%s, err=%v", dir, err)
+			return err
+		}
+
+		resourceObjects = append(resourceObjects, objects...)
+	}
+
+	if len(resourceObjects) == 0 {
+		return errorsx.G11NError("No resources were found in '%s'.", o.dir)
+	}
+
+	handlers := make([]*resource.KindHandler, 0, len(resourceObjects))
+	for _, resourceObject := range resourceObjects {
+		handler, err := resource.GetKindHandler(resourceObject.Kind)
+		if err != nil {
+			return errorsx.G11NError("Unable to import the resource in '%s'; err=%v", resourceObject.Source(), err)
+		}
+
+		handlers = append(handlers, handler)
+	}
+
+	auth, err := o.config.SetAuthToContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// check all the resources before any of them are changed
+	for _, handler := range handlers {
+		if err := auth.CheckEntitlements(ctx, handler.Entitlements); err != nil {
+			return err
+		}
+	}
+
+	r := &resolver{
+		toCtx:     ctx,
+		to:        auth.Tenant,
+		sourceIDs: map[string]map[string]string{},
+		targetIDs: map[string]map[string]string{},
+	}
+
+	// the IDs are recorded before the references to them are replaced
+	for i, resourceObject := range resourceObjects {
+		if resourceObject.Metadata != nil {
+			r.record(handlers[i].Kind, resourceObject.Metadata.UID, handlers[i].Name(resourceObject))
+		}
+	}
+
+	failed := 0
+	for i, resourceObject := range resourceObjects {
+		handler := handlers[i]
+		result, err := o.importObject(r, handler, resourceObject)
+		if err != nil {
+			failed++
+			cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' failed; err=%v", handler.Kind, handler.Name(resourceObject), err))
+			continue
+		}
+
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' %s", handler.Kind, handler.Name(resourceObject), result))
+	}
+
+	if failed > 0 {
+		return errorsx.G11NError("%d of %d resources could not be imported.", failed, len(resourceObjects))
+	}
+
+	return nil
+}
+
+// importObject creates or updates the resource after replacing the IDs of the
+// resources it refers to.
+func (o *importOptions) importObject(r *resolver, handler *resource.KindHandler, obj *resource.ResourceObject) (string, error) {
+	target, err := r.target(obj, handler.Name(obj))
+	if err != nil {
+		return "", err
+	}
+
+	return handler.Apply(r.toCtx, target)
+}
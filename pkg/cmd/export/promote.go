@@ -0,0 +1,460 @@
+package export
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	promoteUsage         = "promote --from=TENANT --to=TENANT [options]"
+	promoteMessagePrefix = "Promote"
+)
+
+// references maps the properties that refer to other resources by ID to the kind
+// of those resources. The members of groups are not listed, because they are
+// identified by userName in the data and looked up by the group handler.
+var references = map[string]string{
+	"sourceId":        resource.ResourceTypePrefix + "Attribute",
+	"identitySource":  resource.ResourceTypePrefix + "IdentitySource",
+	"identitySources": resource.ResourceTypePrefix + "IdentitySource",
+	"authPolicy":      resource.ResourceTypePrefix + "AccessPolicy",
+	"themeId":         resource.ResourceTypePrefix + "Theme",
+}
+
+// skippedKinds are not promoted unless they are requested, because they hold data
+// that is specific to a tenant, such as users or private keys.
+var skippedKinds = []string{
+	resource.ResourceTypePrefix + "User",
+	resource.ResourceTypePrefix + "Group",
+	resource.ResourceTypePrefix + "PersonalCert",
+}
+
+var (
+	promoteLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(promoteMessagePrefix, `
+		Copy the configuration of a tenant to another tenant, such as from development to production.
+
+The resources are read from the source tenant and created or updated on the target tenant in the same
+way as 'import'. Resources are matched by name, because IDs differ between tenants.
+
+Resources refer to each other using IDs, such as the access policy, identity sources and attributes used
+by an application. These references are resolved by name on the source tenant and replaced with the IDs
+of the resources with the same names on the target tenant. A resource fails to be promoted if a resource
+it refers to does not exist on the target tenant and is not promoted along with it.
+
+The tenants are identified by the name of a context, an auth entry or a tenant in the configuration file.
+A session must exist for both of them. For more information:
+
+  verifyctl login -h
+
+Attributes, signer certificates, password policies, identity sources, access policies, API clients,
+applications, identity agents and themes are promoted by default. Users, groups and personal
+certificates are only promoted if they are selected using '--kind'.`))
+
+	promoteExamples = templates.Examples(cmdutil.TranslateExamples(promoteMessagePrefix, `
+		# Promote the configuration from the development tenant to the production tenant
+		verifyctl promote --from=dev.verify.ibm.com --to=prod.verify.ibm.com
+
+		# Promote the applications and the access policies using contexts
+		verifyctl promote --from=dev --to=prod --kind=applications --kind=accesspolicies`))
+)
+
+type promoteOptions struct {
+	from  string
+	to    string
+	kinds []string
+
+	config *config.CLIConfig
+}
+
+func NewPromoteCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &promoteOptions{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   promoteUsage,
+		Short:                 cmdutil.TranslateShortDesc(promoteMessagePrefix, "Copy the configuration of a tenant to another tenant."),
+		Long:                  promoteLongDesc,
+		Example:               promoteExamples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *promoteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.from, "from", o.from, i18n.Translate("Name of the context, auth entry or tenant to read the resources from."))
+	cmd.Flags().StringVar(&o.to, "to", o.to, i18n.Translate("Name of the context, auth entry or tenant to create or update the resources on."))
+	cmd.Flags().StringSliceVar(&o.kinds, "kind", o.kinds, i18n.Translate("Kind of the resources to promote, such as 'applications' or 'IBMVerifyApplication'. The flag can be repeated."))
+}
+
+func (o *promoteOptions) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *promoteOptions) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.from) == 0 {
+		return errorsx.G11NError("'from' flag is required.")
+	}
+
+	if len(o.to) == 0 {
+		return errorsx.G11NError("'to' flag is required.")
+	}
+
+	if o.from == o.to {
+		return errorsx.G11NError("The source and target tenants must be different.")
+	}
+
+	for _, kind := range o.kinds {
+		if len(layoutKind(kind)) == 0 {
+			return errorsx.G11NError("The kind '%s' cannot be promoted.", kind)
+		}
+	}
+
+	return nil
+}
+
+func (o *promoteOptions) Run(cmd *cobra.Command, args []string) error {
+	vc := contextx.GetVerifyContext(cmd.Context())
+
+	// each tenant has its own context, since the tenant and token are held in it
+	fromCtx, err := contextx.NewContextWithVerifyContext(cmd.Context(), vc.Logger)
+	if err != nil {
+		return err
+	}
+
+	toCtx, err := contextx.NewContextWithVerifyContext(cmd.Context(), vc.Logger)
+	if err != nil {
+		return err
+	}
+
+	fromAuth, err := o.config.SetTenantAuthToContext(fromCtx, o.from)
+	if err != nil {
+		return err
+	}
+
+	toAuth, err := o.config.SetTenantAuthToContext(toCtx, o.to)
+	if err != nil {
+		return err
+	}
+
+	// the kinds that are promoted, in the order of the layout
+	kinds := []string{}
+	for _, l := range layout {
+		selected := slices.ContainsFunc(o.kinds, func(k string) bool {
+			return layoutKind(k) == l.kind
+		})
+
+		if len(o.kinds) == 0 {
+			selected = !slices.Contains(skippedKinds, l.kind)
+		}
+
+		if selected {
+			kinds = append(kinds, l.kind)
+		}
+	}
+
+	// check all the kinds before anything is changed
+	handlers := map[string]*resource.KindHandler{}
+	for _, kind := range kinds {
+		handler, err := resource.GetKindHandler(kind)
+		if err != nil {
+			return err
+		}
+
+		if err := fromAuth.CheckEntitlements(cmd.Context(), handler.ReadEntitlements); err != nil {
+			return err
+		}
+
+		if err := toAuth.CheckEntitlements(cmd.Context(), handler.Entitlements); err != nil {
+			return err
+		}
+
+		handlers[kind] = handler
+	}
+
+	r := &resolver{
+		fromCtx:   fromCtx,
+		toCtx:     toCtx,
+		from:      o.from,
+		to:        o.to,
+		sourceIDs: map[string]map[string]string{},
+		targetIDs: map[string]map[string]string{},
+	}
+
+	sourceObjects := map[string][]*resource.ResourceObject{}
+	for _, kind := range kinds {
+		objects, err := r.read(kind)
+		if err != nil {
+			return err
+		}
+
+		sourceObjects[kind] = objects
+	}
+
+	total, failed := 0, 0
+	for _, kind := range kinds {
+		handler := handlers[kind]
+		for _, obj := range sourceObjects[kind] {
+			total++
+			result, err := o.promote(r, handler, obj)
+			if err != nil {
+				failed++
+				cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' failed; err=%v", handler.Kind, obj.Metadata.Name, err))
+				continue
+			}
+
+			cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' %s", handler.Kind, obj.Metadata.Name, result))
+		}
+	}
+
+	if failed > 0 {
+		return errorsx.G11NError("%d of %d resources could not be promoted.", failed, total)
+	}
+
+	return nil
+}
+
+// promote creates or updates the resource on the target tenant after replacing the
+// IDs of the resources it refers to.
+func (o *promoteOptions) promote(r *resolver, handler *resource.KindHandler, obj *resource.ResourceObject) (string, error) {
+	target, err := r.target(obj, obj.Metadata.Name)
+	if err != nil {
+		return "", err
+	}
+
+	return handler.Apply(r.toCtx, target)
+}
+
+// resolver replaces the IDs of the resources on the source tenant with the IDs of
+// the resources with the same names on the target tenant.
+type resolver struct {
+	// fromCtx is nil if the source tenant is not read, such as when the resources
+	// are imported. Only the recorded IDs are replaced then.
+	fromCtx context.Context
+	toCtx   context.Context
+	from    string
+	to      string
+
+	// sourceIDs maps the IDs to the names of the resources on the source tenant
+	// and targetIDs maps the names to the IDs on the target tenant, for each kind.
+	sourceIDs map[string]map[string]string
+	targetIDs map[string]map[string]string
+}
+
+// record records the ID and name of a resource on the source tenant.
+func (r *resolver) record(kind string, id string, name string) {
+	if len(id) == 0 {
+		return
+	}
+
+	if r.sourceIDs[kind] == nil {
+		r.sourceIDs[kind] = map[string]string{}
+	}
+
+	r.sourceIDs[kind][id] = name
+}
+
+// target returns the resource to create or update on the target tenant, with the
+// IDs of the resources it refers to replaced. Its own ID is dropped, because the ID
+// on the source tenant may belong to another resource on the target tenant, so it
+// is matched by name.
+func (r *resolver) target(obj *resource.ResourceObject, name string) (*resource.ResourceObject, error) {
+	data, err := r.remap(obj.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resource.ResourceObject{
+		Kind:       obj.Kind,
+		APIVersion: obj.APIVersion,
+		Metadata: &resource.ResourceObjectMetadata{
+			Name: name,
+		},
+		Data: data,
+	}, nil
+}
+
+// read returns the resources of the kind on the source tenant and records their IDs.
+func (r *resolver) read(kind string) ([]*resource.ResourceObject, error) {
+	vc := contextx.GetVerifyContext(r.fromCtx)
+	handler, err := resource.GetKindHandler(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := handler.Export(r.fromCtx)
+	if err != nil {
+		vc.Logger.Errorf("unable to list the resources; tenant=%s, kind=%s, err=%v", vc.Tenant, kind, err)
+		return nil, errorsx.G11NError("Unable to read the '%s' resources from '%s'; err=%v", kind, r.from, err)
+	}
+
+	r.sourceIDs[kind] = map[string]string{}
+	for _, obj := range objects {
+		r.record(kind, obj.Metadata.UID, obj.Metadata.Name)
+	}
+
+	return objects, nil
+}
+
+func (r *resolver) remap(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(t))
+		for key, value := range t {
+			var err error
+			if kind, ok := references[key]; ok {
+				ret[key], err = r.remapReference(kind, value)
+			} else {
+				ret[key], err = r.remap(value)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return ret, nil
+	case []interface{}:
+		ret := make([]interface{}, 0, len(t))
+		for _, value := range t {
+			mapped, err := r.remap(value)
+			if err != nil {
+				return nil, err
+			}
+
+			ret = append(ret, mapped)
+		}
+
+		return ret, nil
+	}
+
+	return v, nil
+}
+
+// remapReference replaces the ID in the value, which is an ID, a list of IDs or an
+// object with the ID in its 'id' property.
+func (r *resolver) remapReference(kind string, value interface{}) (interface{}, error) {
+	switch t := value.(type) {
+	case string:
+		return r.resolve(kind, t)
+	case []interface{}:
+		ret := make([]interface{}, 0, len(t))
+		for _, item := range t {
+			mapped, err := r.remapReference(kind, item)
+			if err != nil {
+				return nil, err
+			}
+
+			ret = append(ret, mapped)
+		}
+
+		return ret, nil
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(t))
+		for key, item := range t {
+			ret[key] = item
+		}
+
+		if id, ok := t["id"].(string); ok {
+			mapped, err := r.resolve(kind, id)
+			if err != nil {
+				return nil, err
+			}
+
+			ret["id"] = mapped
+		}
+
+		return ret, nil
+	}
+
+	return value, nil
+}
+
+// resolve returns the ID on the target tenant of the resource with the ID on the
+// source tenant. IDs that do not belong to a resource on the source tenant, such
+// as the IDs of built-in resources, are not changed.
+func (r *resolver) resolve(kind string, id string) (string, error) {
+	if len(id) == 0 {
+		return id, nil
+	}
+
+	// the resources of the kind are read when they are first referred to
+	if _, ok := r.sourceIDs[kind]; !ok && r.fromCtx != nil {
+		if _, err := r.read(kind); err != nil {
+			return "", err
+		}
+	}
+
+	name, ok := r.sourceIDs[kind][id]
+	if !ok {
+		return id, nil
+	}
+
+	if targetID, ok := r.targetIDs[kind][name]; ok {
+		return targetID, nil
+	}
+
+	handler, err := resource.GetKindHandler(kind)
+	if err != nil {
+		return "", err
+	}
+
+	current, err := handler.Find(r.toCtx, &resource.ResourceObject{
+		Kind: kind,
+		Metadata: &resource.ResourceObjectMetadata{
+			Name: name,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if current == nil {
+		return "", errorsx.G11NError("The %s '%s' does not exist on '%s'.", kind, name, r.to)
+	}
+
+	// resources that do not exist yet are not cached, since they may be promoted
+	if r.targetIDs[kind] == nil {
+		r.targetIDs[kind] = map[string]string{}
+	}
+
+	r.targetIDs[kind][name] = current.Metadata.UID
+	return current.Metadata.UID, nil
+}
+
+// layoutKind returns the kind in the layout with the name, which can be the kind
+// or the name of its directory, such as 'applications'.
+func layoutKind(name string) string {
+	for _, l := range layout {
+		if strings.EqualFold(name, l.kind) || strings.EqualFold(name, l.dir) {
+			return l.kind
+		}
+	}
+
+	return ""
+}
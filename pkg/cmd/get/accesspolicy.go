@@ -16,11 +16,12 @@ import (
 const (
 	accessPoliciesUsage         = `accesspolicies [flags]`
 	accessPoliciesMessagePrefix = "GetAccesspolicies"
-	accessPoliciesEntitlements  = "Manage accessPolicies"
 	accessPolicyResourceName    = "accesspolicy"
 )
 
 var (
+	accessPoliciesEntitlements = config.Entitlements{config.EntitlementManageAccessPolicies, config.EntitlementReadAccessPolicies}
+
 	accessPoliciesLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(accessPoliciesMessagePrefix, `
 		Get Verify accessPolicies based on an optional filter or a specific accessPolicy.
 		
@@ -98,11 +99,11 @@ func (o *accessPoliciesOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *accessPoliciesOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+accessPoliciesEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+accessPoliciesEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), accessPoliciesEntitlements...)
 	if err != nil {
 		return err
 	}
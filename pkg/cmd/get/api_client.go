@@ -16,11 +16,12 @@ import (
 const (
 	apiclientUsage          = `apiclients [flags]`
 	apiclientsMessagePrefix = "Getapiclients"
-	apiclientsEntitlements  = "Manage apiclients"
 	apiclientResourceName   = "apiclient"
 )
 
 var (
+	apiclientsEntitlements = config.Entitlements{config.EntitlementManageAPIClients, config.EntitlementReadAPIClients}
+
 	apiclientLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(apiclientsMessagePrefix, `
 		Get API clients based on an optional filter or a specific apiclient.
 		
@@ -103,11 +104,11 @@ func (o *apiclientsOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *apiclientsOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+apiclientsEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+apiclientsEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), apiclientsEntitlements...)
 	if err != nil {
 		return err
 	}
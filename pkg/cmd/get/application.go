@@ -16,11 +16,12 @@ import (
 const (
 	applicationUsage          = "applications [options]"
 	applicationsMessagePrefix = "GetApplications"
-	applicationsEntitlements  = "Manage applications"
 	applicationResourceName   = "application"
 )
 
 var (
+	applicationsEntitlements = config.Entitlements{config.EntitlementManageAppAccessAdmin}
+
 	applicationLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(applicationsMessagePrefix, `
         Get Verify application based on an optional filter or a specific application.
         Resources managed on Verify have specific entitlements, so ensure that the application or application used with the 'auth' command is configured with the appropriate entitlements.
@@ -88,10 +89,10 @@ func (o *applicationsOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *applicationsOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+" "+applicationsEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+applicationsEntitlements.String())
 		return nil
 	}
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), applicationsEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -16,11 +16,12 @@ import (
 const (
 	attributesUsage         = `attributes [flags]`
 	attributesMessagePrefix = "GetAttributes"
-	attributesEntitlements  = "Manage attributes"
 	attributeResourceName   = "attribute"
 )
 
 var (
+	attributesEntitlements = config.Entitlements{config.EntitlementManageAttributes, config.EntitlementReadAttributes}
+
 	attributesLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(attributesMessagePrefix, `
 		Get Verify attributes based on an optional filter or a specific attribute.
 		
@@ -99,11 +100,11 @@ func (o *attributesOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *attributesOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+attributesEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+attributesEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), attributesEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -16,11 +16,12 @@ import (
 const (
 	groupsUsage         = `groups [flags]`
 	groupsMessagePrefix = "GetGroups"
-	groupsEntitlements  = "Manage groups"
 	groupResourceName   = "group"
 )
 
 var (
+	groupsEntitlements = config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups, config.EntitlementReadUserGroups}
+
 	groupsLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(groupsMessagePrefix, `
 		Get Verify groups based on an optional filter or a specific group.
 		
@@ -98,11 +99,11 @@ func (o *groupsOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *groupsOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+groupsEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+groupsEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), groupsEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -16,11 +16,12 @@ import (
 const (
 	identityAgentsUsage         = `identityagents [flags]`
 	identityAgentsMessagePrefix = "Getidentityagents"
-	identityAgentsEntitlements  = "Manage identityagents"
 	identityAgentResourceName   = "identityagent"
 )
 
 var (
+	identityAgentsEntitlements = config.Entitlements{config.EntitlementManageExternalAgents, config.EntitlementReadExternalAgents}
+
 	identityAgentLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(identityAgentsMessagePrefix, `
 		Get Identity Agents based on an optional filter or a specific identityagent.
 		
@@ -101,11 +102,11 @@ func (o *identityAgentsOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *identityAgentsOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+identityAgentsEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+identityAgentsEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), identityAgentsEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -16,11 +16,12 @@ import (
 const (
 	identitySourcesUsage         = `identitysources [flags]`
 	identitySourcesMessagePrefix = "GetIdentitySources"
-	identitySourcesEntitlements  = "Manage identitySources"
 	identitySourceResourceName   = "identitysource"
 )
 
 var (
+	identitySourcesEntitlements = config.Entitlements{config.EntitlementManageIdentitySources, config.EntitlementReadIdentitySources}
+
 	identitySourcesLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(identitySourcesMessagePrefix, `
 		Get Verify identitySources based on an optional filter or a specific identitySource.
 		
@@ -99,11 +100,11 @@ func (o *identitySourcesOptions) Validate(cmd *cobra.Command, args []string) err
 
 func (o *identitySourcesOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+identitySourcesEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+identitySourcesEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), identitySourcesEntitlements...)
 	if err != nil {
 		return err
 	}
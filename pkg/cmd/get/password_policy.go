@@ -16,11 +16,12 @@ import (
 const (
 	passwordPolicyUsage         = `passwordpolicies [flags]`
 	passwordPolicyMessagePrefix = "GetPasswordPolicies"
-	passwordPolicyEntitlements  = "Manage password policies"
 	passwordPolicyResourceName  = "passwordpolicy"
 )
 
 var (
+	passwordPolicyEntitlements = config.Entitlements{config.EntitlementManagePwdPolicy, config.EntitlementReadPwdPolicy}
+
 	passwordPolicyLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(passwordPolicyMessagePrefix, `
 Get Verify password policies based on an optional filter or a specific password policy.
 
@@ -97,11 +98,11 @@ func (o *passwordPolicyOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *passwordPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+passwordPolicyEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+passwordPolicyEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), passwordPolicyEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -16,11 +16,12 @@ import (
 const (
 	personalCertUsage         = `personalCerts [flags]`
 	personalCertMessagePrefix = "GetPersonalCerts"
-	personalCertEntitlements  = "Manage Personal Certs"
 	personalCertResourceName  = "personalCert"
 )
 
 var (
+	personalCertEntitlements = config.Entitlements{config.EntitlementManageCerts, config.EntitlementReadCerts}
+
 	personalCertLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(personalCertMessagePrefix, `
 		Get Verify personal certificate based on an optional filter or a specific personal certificate.
  
@@ -88,11 +89,11 @@ func (o *personalCertOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *personalCertOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+" "+personalCertEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+personalCertEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), personalCertEntitlements...)
 	if err != nil {
 		return err
 	}
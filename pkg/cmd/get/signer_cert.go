@@ -16,11 +16,12 @@ import (
 const (
 	signerCertUsage         = `signerCerts [flags]`
 	signerCertMessagePrefix = "GetSignerCerts"
-	signerCertEntitlements  = "Manage Signer Certs"
 	signerCertResourceName  = "signerCert"
 )
 
 var (
+	signerCertEntitlements = config.Entitlements{config.EntitlementManageCerts, config.EntitlementReadCerts}
+
 	signerCertLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(signerCertMessagePrefix, `
 		Get Verify Signer certificate based on an optional filter or a specific Signer certificate.
 
@@ -90,11 +91,11 @@ func (o *signerCertOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *signerCertOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+" "+signerCertEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+signerCertEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), signerCertEntitlements...)
 	if err != nil {
 		return err
 	}
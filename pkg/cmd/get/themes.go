@@ -17,11 +17,12 @@ import (
 const (
 	themesUsage         = `themes [flags]`
 	themesMessagePrefix = "GetThemes"
-	themesEntitlements  = "manageTemplates (Manage templates and themes) or readTemplates (Read templates and themes)"
 	themeResourceName   = "theme"
 )
 
 var (
+	themesEntitlements = config.Entitlements{config.EntitlementManageTemplates, config.EntitlementReadTemplates}
+
 	themesLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(themesMessagePrefix, `
 		Get themes.
 		
@@ -116,11 +117,11 @@ func (o *themesOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *themesOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+themesEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+themesEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), themesEntitlements...)
 	if err != nil {
 		return err
 	}
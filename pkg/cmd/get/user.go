@@ -16,11 +16,12 @@ import (
 const (
 	usersUsage         = `users [flags]`
 	usersMessagePrefix = "GetUsers"
-	usersEntitlements  = "Manage users"
 	userResourceName   = "user"
 )
 
 var (
+	usersEntitlements = config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups, config.EntitlementReadUserGroups}
+
 	usersLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(usersMessagePrefix, `
 		Get Verify users based on an optional filter or a specific user.
 		
@@ -98,11 +99,11 @@ func (o *usersOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *usersOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+usersEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+usersEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	_, err := o.config.SetAuthToContext(cmd.Context(), usersEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -14,10 +14,11 @@ import (
 const (
 	usage         = "logs [flags]"
 	messagePrefix = "Logs"
-	entitlements  = "Read trace logs (readTraceLogs)"
 )
 
 var (
+	entitlements = config.Entitlements{config.EntitlementReadTraceLogs}
+
 	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
 		Print logs from your Verify tenant.
 		
@@ -92,11 +93,11 @@ func (o *options) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *options) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+entitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+entitlements.String())
 		return nil
 	}
 
-	auth, err := o.config.SetAuthToContext(cmd.Context())
+	auth, err := o.config.SetAuthToContext(cmd.Context(), entitlements...)
 	if err != nil {
 		return err
 	}
@@ -3,7 +3,6 @@ package replace
 import (
 	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
@@ -12,6 +11,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -19,11 +19,12 @@ import (
 const (
 	accessPolicyUsage         = `accesspolicy [options]`
 	accessPolicyMessagePrefix = "UpdateAccessPolicy"
-	accessPolicyEntitlements  = "Manage accessPolicies"
 	accessPolicyResourceName  = "accesspolicy"
 )
 
 var (
+	accessPolicyEntitlements = config.Entitlements{config.EntitlementManageAccessPolicies}
+
 	accessPolicieshortDesc = cmdutil.TranslateShortDesc(accessPolicyMessagePrefix, "Update a accessPolicy resource.")
 
 	accessPolicyLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(accessPolicyMessagePrefix, `
@@ -103,7 +104,7 @@ func (o *accessPolicyOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *accessPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+accessPolicyEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+accessPolicyEntitlements.String())
 		return nil
 	}
 
@@ -120,7 +121,16 @@ func (o *accessPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"AccessPolicy", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), accessPolicyEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -129,13 +139,9 @@ func (o *accessPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *accessPolicyOptions) updateAccessPolicy(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// read the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -162,31 +168,3 @@ func (o *accessPolicyOptions) updateAccessPolicyWithData(cmd *cobra.Command, dat
 	cmdutil.WriteString(cmd, "Access Policy updated successfully")
 	return nil
 }
-
-func (o *accessPolicyOptions) updateAccessPolicyFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// unmarshal to accessPolicy object
-	accessPolicy := &security.Policy{}
-	b, err := json.Marshal(data)
-
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, accessPolicy); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to a accessPolicy; err=%v", err)
-		return err
-	}
-
-	client := security.NewAccessPolicyClient()
-	if err := client.UpdateAccessPolicy(ctx, accessPolicy); err != nil {
-		vc.Logger.Errorf("unable to update the accessPolicy; err=%v, accessPolicy=%+v", err, accessPolicy)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Access Policy updated successfully")
-	return nil
-}
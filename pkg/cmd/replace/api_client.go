@@ -1,15 +1,14 @@
 package replace
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -21,11 +20,12 @@ import (
 const (
 	apiclientUsage         = `apiclient [options]`
 	apiclientMessagePrefix = "UpdateApiclient"
-	apiclientEntitlements  = "manageAPIClients"
 	apiclientResourceName  = "apiclient"
 )
 
 var (
+	apiclientEntitlements = config.Entitlements{config.EntitlementManageAPIClients}
+
 	apiclientShortDesc = cmdutil.TranslateShortDesc(apiclientMessagePrefix, "Update an API client resource.")
 	apiclientLongDesc  = templates.LongDesc(cmdutil.TranslateLongDesc(apiclientMessagePrefix, `
         Update an API client resource. Resources managed on Verify require specific entitlements, so ensure that the application or API client used
@@ -99,7 +99,7 @@ func (o *apiclientOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *apiclientOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+apiclientEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+apiclientEntitlements.String())
 		return nil
 	}
 
@@ -118,7 +118,16 @@ func (o *apiclientOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"APIClient", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), apiclientEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -127,12 +136,8 @@ func (o *apiclientOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *apiclientOptions) updateAPIClient(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -158,29 +163,3 @@ func (o *apiclientOptions) updateAPIClientWithData(cmd *cobra.Command, data []by
 	cmdutil.WriteString(cmd, "API client updated successfully")
 	return nil
 }
-
-func (o *apiclientOptions) updateAPIClientFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	apiclient := &security.APIClientConfig{}
-	b, err := json.Marshal(data)
-
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, apiclient); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to a API client; err=%v", err)
-		return err
-	}
-	client := security.NewAPIClient()
-	if err := client.UpdateAPIClient(ctx, apiclient); err != nil {
-		vc.Logger.Errorf("unable to update the API client; err=%v, apiclient=%+v", err, apiclient)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "API client updated successfully")
-	return nil
-}
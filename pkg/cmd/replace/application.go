@@ -2,7 +2,6 @@ package replace
 
 import (
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/applications"
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
@@ -11,6 +10,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -19,11 +19,12 @@ import (
 const (
 	applicationUsage         = "application [options]"
 	applicationMessagePrefix = "UpdateApplication"
-	applicationEntitlements  = "ManageApplications"
 	applicationResourceName  = "application"
 )
 
 var (
+	applicationEntitlements = config.Entitlements{config.EntitlementManageAppAccessAdmin}
+
 	applicationShortDesc = cmdutil.TranslateShortDesc(applicationMessagePrefix, "Update Application resource.")
 	applicationLongDesc  = templates.LongDesc(cmdutil.TranslateLongDesc(applicationMessagePrefix, `
         Update an Application resource. Resources managed on Verify require specific entitlements, so ensure that the application or API client used
@@ -103,7 +104,7 @@ func (o *applicationOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *applicationOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+applicationEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+applicationEntitlements.String())
 		return nil
 	}
 
@@ -121,7 +122,16 @@ func (o *applicationOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"Application", o.applicationID)
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), applicationEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -130,12 +140,8 @@ func (o *applicationOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *applicationOptions) updateApplication(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -173,30 +179,3 @@ func (o *applicationOptions) updateApplicationWithData(cmd *cobra.Command, data
 	cmdutil.WriteString(cmd, "Application updated successfully")
 	return nil
 }
-
-func (o *applicationOptions) updateApplicationFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	application := &applications.Application{}
-	b, err := yaml.Marshal(data)
-
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := yaml.Unmarshal(b, application); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an Application; err=%v", err)
-		return err
-	}
-
-	client := applications.NewApplicationClient()
-	if err := client.UpdateApplication(ctx, o.applicationID, application); err != nil {
-		vc.Logger.Errorf("unable to update the Application; err=%v, application=%+v", err, application)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Application updated successfully")
-	return nil
-}
@@ -3,13 +3,13 @@ package replace
 import (
 	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -20,11 +20,12 @@ import (
 const (
 	attributeUsage         = `attribute [options]`
 	attributeMessagePrefix = "UpdateAttribute"
-	attributeEntitlements  = "Manage attributes"
 	attributeResourceName  = "attribute"
 )
 
 var (
+	attributeEntitlements = config.Entitlements{config.EntitlementManageAttributes}
+
 	attributeShortDesc = cmdutil.TranslateShortDesc(attributeMessagePrefix, "Additional options to update an attribute.")
 
 	attributeLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(attributeMessagePrefix, `
@@ -104,7 +105,7 @@ func (o *attributeOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *attributeOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+attributeEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+attributeEntitlements.String())
 		return nil
 	}
 
@@ -123,7 +124,16 @@ func (o *attributeOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"Attribute", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), attributeEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -132,13 +142,9 @@ func (o *attributeOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *attributeOptions) updateAttribute(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// get the contents of the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -165,30 +171,3 @@ func (o *attributeOptions) updateAttributeWithData(cmd *cobra.Command, data []by
 	cmdutil.WriteString(cmd, "Resource updated")
 	return nil
 }
-
-func (o *attributeOptions) updateAttributeFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// unmarshal to attribute
-	attribute := &directory.Attribute{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, attribute); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to an attribute; err=%v", err)
-		return err
-	}
-
-	client := directory.NewAttributeClient()
-	if err := client.UpdateAttribute(ctx, attribute); err != nil {
-		vc.Logger.Errorf("unable to create the attribute; err=%v, attribute=%+v", err, attribute)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource updated")
-	return nil
-}
@@ -3,13 +3,13 @@ package replace
 import (
 	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -20,11 +20,12 @@ import (
 const (
 	groupUsage         = `group [options]`
 	groupMessagePrefix = "UpdateGroup"
-	groupEntitlements  = "Manage groups"
 	groupResourceName  = "group"
 )
 
 var (
+	groupEntitlements = config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups}
+
 	groupShortDesc = cmdutil.TranslateShortDesc(groupMessagePrefix, "Update a group resource.")
 
 	groupLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(groupMessagePrefix, `
@@ -104,7 +105,7 @@ func (o *groupOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *groupOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+groupEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+groupEntitlements.String())
 		return nil
 	}
 	id := "<id>"
@@ -122,7 +123,16 @@ func (o *groupOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"Group", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), groupEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -131,13 +141,9 @@ func (o *groupOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *groupOptions) updateGroup(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// read the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -164,31 +170,3 @@ func (o *groupOptions) updateGroupWithData(cmd *cobra.Command, data []byte) erro
 	cmdutil.WriteString(cmd, "Group updated successfully")
 	return nil
 }
-
-func (o *groupOptions) updateGroupFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// unmarshal to group object
-	group := &directory.GroupPatchRequest{}
-	b, err := json.Marshal(data)
-
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, group); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to a group; err=%v", err)
-		return err
-	}
-
-	client := directory.NewGroupClient()
-	if err := client.UpdateGroup(ctx, group.GroupName, &group.SCIMPatchRequest.Operations); err != nil {
-		vc.Logger.Errorf("unable to update the group; err=%v, group=%+v", err, group)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Group updated successfully")
-	return nil
-}
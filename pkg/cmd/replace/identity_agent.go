@@ -2,7 +2,6 @@ package replace
 
 import (
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/integrations"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -20,11 +20,12 @@ import (
 const (
 	identityAgentUsage         = `identityagent [options]`
 	identityAgentMessagePrefix = "UpdateIdentityAgent"
-	identityAgentEntitlements  = "Manage identity agent"
 	identityAgentResourceName  = "identityagent"
 )
 
 var (
+	identityAgentEntitlements = config.Entitlements{config.EntitlementManageExternalAgents}
+
 	identityAgentShortDesc = cmdutil.TranslateShortDesc(identityAgentMessagePrefix, "Update an identity agent resource.")
 
 	identityAgentLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(identityAgentMessagePrefix, `
@@ -104,7 +105,7 @@ func (o *identityAgentOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *identityAgentOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+identityAgentEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+identityAgentEntitlements.String())
 		return nil
 	}
 
@@ -122,7 +123,16 @@ func (o *identityAgentOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"IdentityAgent", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), identityAgentEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -131,13 +141,8 @@ func (o *identityAgentOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *identityAgentOptions) updateIdentityAgent(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
-
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 	return o.updateIdentityAgentWithData(cmd, b)
@@ -180,30 +185,3 @@ func (o *identityAgentOptions) updateIdentityAgentWithData(cmd *cobra.Command, d
 	cmdutil.WriteString(cmd, "Identity Agent updated successfully")
 	return nil
 }
-
-func (o *identityAgentOptions) updateIdentityAgentFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	identityAgent := &integrations.IdentityAgentConfig{}
-	b, err := yaml.Marshal(data)
-
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := yaml.Unmarshal(b, identityAgent); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to a identity agent; err=%v", err)
-		return err
-	}
-
-	client := integrations.NewIdentityAgentClient()
-	if err := client.UpdateIdentityAgent(ctx, identityAgent); err != nil {
-		vc.Logger.Errorf("unable to update identity agent; err=%v, identityAgent=%+v", err, identityAgent)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Identity Agent updated successfully")
-	return nil
-}
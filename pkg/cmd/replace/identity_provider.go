@@ -1,15 +1,14 @@
 package replace
 
 import (
-	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/authentication"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -21,11 +20,12 @@ import (
 const (
 	identitySourceUsage         = `identitysource [options]`
 	identitySourceMessagePrefix = "UpdateIdentitySource"
-	identitySourceEntitlements  = "Manage identitySources"
 	identitySourceResourceName  = "identitysource"
 )
 
 var (
+	identitySourceEntitlements = config.Entitlements{config.EntitlementManageIdentitySources}
+
 	identitySourceShortDesc = cmdutil.TranslateShortDesc(identitySourceMessagePrefix, "Update a identitySource resource.")
 
 	identitySourceLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(identitySourceMessagePrefix, `
@@ -111,7 +111,7 @@ func (o *identitySourceOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *identitySourceOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+identitySourceEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+identitySourceEntitlements.String())
 		return nil
 	}
 
@@ -128,7 +128,16 @@ func (o *identitySourceOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"IdentitySource", o.identitySourceID)
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), identitySourceEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -137,13 +146,9 @@ func (o *identitySourceOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *identitySourceOptions) updateIdentitySource(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// read the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -182,31 +187,3 @@ func (o *identitySourceOptions) updateIdentitySourceWithData(cmd *cobra.Command,
 	cmdutil.WriteString(cmd, "IdentitySource updated successfully")
 	return nil
 }
-
-func (o *identitySourceOptions) updateIdentitySourceFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// unmarshal to identitySource object
-	identitySource := &authentication.IdentitySource{}
-	b, err := json.Marshal(data)
-
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, identitySource); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to a Identity Source err=%v", err)
-		return err
-	}
-
-	client := authentication.NewIdentitySourceClient()
-	if err := client.UpdateIdentitySource(ctx, "", identitySource); err != nil {
-		vc.Logger.Errorf("unable to update the Identity Source err=%v, identitySource=%+v", err, identitySource)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "IdentitySource updated successfully")
-	return nil
-}
@@ -2,7 +2,6 @@ package replace
 
 import (
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -20,11 +20,12 @@ import (
 const (
 	passwordPolicyUsage         = `passwordpolicy [options]`
 	passwordPolicyMessagePrefix = "UpdatePasswordPolicy"
-	passwordPolicyEntitlements  = "Manage password policies"
 	passwordPolicyResourceName  = "passwordpolicy"
 )
 
 var (
+	passwordPolicyEntitlements = config.Entitlements{config.EntitlementManagePwdPolicy}
+
 	passwordPolicyShortDesc = cmdutil.TranslateShortDesc(passwordPolicyMessagePrefix, "Update a password policy resource.")
 
 	passwordPolicyLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(passwordPolicyMessagePrefix, `
@@ -104,7 +105,7 @@ func (o *passwordPolicyOptions) Validate(cmd *cobra.Command, args []string) erro
 
 func (o *passwordPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+passwordPolicyEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+passwordPolicyEntitlements.String())
 		return nil
 	}
 
@@ -122,7 +123,16 @@ func (o *passwordPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"PasswordPolicy", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), passwordPolicyEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -131,13 +141,8 @@ func (o *passwordPolicyOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *passwordPolicyOptions) updatePasswordPolicy(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	b, err := os.ReadFile(o.file)
-
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 	return o.updatePasswordPolicyWithData(cmd, b)
@@ -163,30 +168,3 @@ func (o *passwordPolicyOptions) updatePasswordPolicyWithData(cmd *cobra.Command,
 	cmdutil.WriteString(cmd, "Password Policy updated successfully")
 	return nil
 }
-
-func (o *passwordPolicyOptions) updatePasswordPolicyFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	passwordPolicy := &security.PasswordPolicy{}
-	b, err := yaml.Marshal(data)
-
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := yaml.Unmarshal(b, passwordPolicy); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to a password policy; err=%v", err)
-		return err
-	}
-
-	client := security.NewPasswordPolicyClient()
-	if err := client.UpdatePasswordPolicy(ctx, passwordPolicy); err != nil {
-		vc.Logger.Errorf("unable to update password policy; err=%v, passwordPolicy=%+v", err, passwordPolicy)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Password Policy updated successfully")
-	return nil
-}
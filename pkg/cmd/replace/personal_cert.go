@@ -2,7 +2,6 @@ package replace
 
 import (
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
@@ -11,6 +10,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -19,11 +19,12 @@ import (
 const (
 	personalCertUsage         = `personalCert [options]`
 	personalCertMessagePrefix = "UpdatePersonalCert"
-	personalCertEntitlements  = "Manage Personal Certs"
 	personalCertResourceName  = "personalCert"
 )
 
 var (
+	personalCertEntitlements = config.Entitlements{config.EntitlementManageCerts}
+
 	personalCertShortDesc = cmdutil.TranslateShortDesc(personalCertMessagePrefix, "Update a personal certificate resource.")
 
 	personalCertLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(personalCertMessagePrefix, `
@@ -89,7 +90,7 @@ func (o *personalCertOptions) Validate(cmd *cobra.Command, args []string) error
 
 func (o *personalCertOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+" "+personalCertEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+personalCertEntitlements.String())
 		return nil
 	}
 
@@ -105,7 +106,16 @@ func (o *personalCertOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"PersonalCert", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), personalCertEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -114,11 +124,8 @@ func (o *personalCertOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *personalCertOptions) updatePersonalCert(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 	return o.updatePersonalCertWithData(cmd, b)
@@ -142,28 +149,3 @@ func (o *personalCertOptions) updatePersonalCertWithData(cmd *cobra.Command, dat
 	cmdutil.WriteString(cmd, "Personal Certificate updated successfully")
 	return nil
 }
-
-func (o *personalCertOptions) updatePersonalCertFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-	personalCert := &security.PersonalCert{}
-	b, err := yaml.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := yaml.Unmarshal(b, personalCert); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to a personal certificate; err=%v", err)
-		return err
-	}
-
-	client := security.NewPersonalCertClient()
-	if err := client.UpdatePersonalCert(ctx, personalCert); err != nil {
-		vc.Logger.Errorf("unable to update personal certificate; err=%v, personalCert=%+v", err, personalCert)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Resource updated")
-	return nil
-}
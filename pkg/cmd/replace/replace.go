@@ -7,6 +7,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,28 @@ var (
 
 JSON or YAML formats are accepted and determined based on the file extension.
 
+A file can hold several resources, either as YAML documents separated by '---' or as an 'IBMVerifyList',
+such as the output of 'get'. If a directory is provided, the resources in all of its JSON and YAML files
+are read. Use '-R' to read the subdirectories as well.
+
+Secrets, such as 'clientSecret', 'client_secret', 'password' and the values of properties marked as
+'sensitive', can be references so that the file can be committed without them:
+
+  - 'env:NAME' reads the environment variable
+  - 'file:/path' reads the file
+  - 'exec:command args' runs the command and reads its output
+
+Use '--dry-run' to preview the changes without making them:
+
+  - 'client' prints the requests that would update the resources, including their payload, instead of
+    sending them. For users and groups, these are the SCIM patch operations. The tenant is still read
+    to look up the IDs of the resources.
+  - 'server' checks the data against the schema of each resource, as 'verifyctl validate' does, and
+    reads the tenant to check that all of the resources exist. Verify does not offer validation-only
+    endpoints for these resources, so the data itself is not checked by the tenant.
+
+The commands of the resource types, such as 'verifyctl replace attribute', support '--dry-run' as well.
+
 An empty resource file can be generated using:
 
   verifyctl replace [resource-type] --boilerplate
@@ -44,7 +67,13 @@ Certain resources may offer additional options and can be determined using:
 
 	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
 		# Update an application
-		verifyctl replace -f=./app-1098012.json`))
+		verifyctl replace -f=./app-1098012.json
+
+		# Update the applications in a directory
+		verifyctl replace -f=./applications
+
+		# Print the SCIM patch operations that would update a user
+		verifyctl replace -f=./user-jdoe.yaml --dry-run=client`))
 
 	entitlementsMessage = i18n.Translate("Choose any of the following entitlements to configure your application or API client:\n")
 )
@@ -53,6 +82,7 @@ type options struct {
 	entitlements bool
 	boilerplate  bool
 	file         string
+	recursive    bool
 	//output       string
 
 	config *config.CLIConfig
@@ -102,10 +132,15 @@ func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string)
 func (o *options) addCommonFlags(cmd *cobra.Command, resourceName string) {
 	cmd.Flags().BoolVar(&o.entitlements, "entitlements", o.entitlements, i18n.Translate("List the entitlements that can be configured to grant access to the resource. This is useful to know what to configure on the application or API client used to generate the login token. When this flag is used, the others are ignored."))
 	cmd.Flags().BoolVar(&o.boilerplate, "boilerplate", o.boilerplate, i18n.TranslateWithArgs("Generate an empty %s file. This will be in YAML format.", resourceName))
+	resource.AddTemplateFlags(cmd)
+	dryrun.AddFlag(cmd)
 }
 
 func (o *options) AddFlags(cmd *cobra.Command) {
-	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file that contains the input data. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml."))
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file or directory that contains the input data. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml. Use '-' to read from stdin."))
+	cmd.Flags().BoolVarP(&o.recursive, "recursive", "R", o.recursive, i18n.Translate("Read the files in the subdirectories as well when the input is a directory."))
+	resource.AddTemplateFlags(cmd)
+	dryrun.AddFlag(cmd)
 	//cmd.Flags().StringVarP(&o.output, "output", "o", "", i18n.Translate("Fetches the newly created resource in the indicated format. The values supported are 'json' , 'yaml' and 'raw'. Default: 'json'."))
 }
 
@@ -122,79 +157,115 @@ func (o *options) Run(cmd *cobra.Command, args []string) error {
 		return errorsx.G11NError("'file' option is required.")
 	}
 
-	// read the file
-	resourceObject, err := o.readFile(cmd)
+	mode, err := dryrun.Mode(cmd)
 	if err != nil {
 		return err
 	}
 
-	if len(resourceObject.Kind) == 0 {
-		return errorsx.G11NError("No 'kind' defined. Resource type cannot be identified.")
+	resourceObjects, err := resource.LoadObjects(cmd, o.file, o.recursive)
+	if err != nil {
+		return err
 	}
 
-	_, err = o.config.SetAuthToContext(cmd.Context())
+	return updateResources(cmd, o.config, mode, resourceObjects)
+}
+
+// updateFileDryRun previews updating the resource in the file read by the commands
+// of the resource types. The resource is identified by the ID, if the command has
+// one, or else by the name in the data.
+func updateFileDryRun(cmd *cobra.Command, config *config.CLIConfig, mode string, file string, kind string, id string) error {
+	resourceObject, err := resource.LoadDataFile(cmd, file, kind)
 	if err != nil {
 		return err
 	}
 
-	switch resourceObject.Kind {
-	case resource.ResourceTypePrefix + "Attribute":
-		options := &attributeOptions{}
-		err = options.updateAttributeFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	if len(id) > 0 {
+		if resourceObject.Metadata == nil {
+			resourceObject.Metadata = &resource.ResourceObjectMetadata{}
+		}
 
-	case resource.ResourceTypePrefix + "User":
-		options := &userOptions{}
-		err = options.updateUserFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+		resourceObject.Metadata.UID = id
+	}
 
-	case resource.ResourceTypePrefix + "Group":
-		options := &groupOptions{}
-		err = options.updateGroupFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	return updateResources(cmd, config, mode, []*resource.ResourceObject{resourceObject})
+}
 
-	case resource.ResourceTypePrefix + "AccessPolicy":
-		options := &accessPolicyOptions{}
-		err = options.updateAccessPolicyFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+// updateResources updates the resources, or previews updating them in a dry run.
+func updateResources(cmd *cobra.Command, config *config.CLIConfig, mode string, resourceObjects []*resource.ResourceObject) error {
+	auth, err := config.SetAuthToContext(cmd.Context())
+	if err != nil {
+		return err
+	}
 
-	case resource.ResourceTypePrefix + "IdentitySource":
-		options := &identitySourceOptions{}
-		err = options.updateIdentitySourceFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	// check all the resources before any of them are updated
+	handlers := make([]*resource.KindHandler, 0, len(resourceObjects))
+	for _, resourceObject := range resourceObjects {
+		if len(resourceObject.Kind) == 0 {
+			return errorsx.G11NError("No 'kind' defined in '%s'. Resource type cannot be identified.", resourceObject.Source())
+		}
 
-	case resource.ResourceTypePrefix + "APIClient":
-		options := &apiclientOptions{}
-		err = options.updateAPIClientFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+		handler, err := resource.GetKindHandler(resourceObject.Kind)
+		if err != nil || !handler.Updatable() {
+			return errorsx.G11NError("The kind '%s' in '%s' is not supported.", resourceObject.Kind, resourceObject.Source())
+		}
 
-	case resource.ResourceTypePrefix + "Application":
-		options := &applicationOptions{}
-		err = options.updateApplicationFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+		if err := auth.CheckEntitlements(cmd.Context(), handler.Entitlements); err != nil {
+			return err
+		}
 
-	case resource.ResourceTypePrefix + "PasswordPolicy":
-		options := &passwordPolicyOptions{}
-		err = options.updatePasswordPolicyFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+		handlers = append(handlers, handler)
+	}
 
-	case resource.ResourceTypePrefix + "SignInOptions":
-		options := &signInOptions{}
-		err = options.updateSignInOptionsFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	if mode == dryrun.Client {
+		cmd.SetContext(dryrun.WithClient(cmd.Context(), cmd.OutOrStdout()))
+	}
 
-	case resource.ResourceTypePrefix + "IdentityAgent":
-		options := &identityAgentOptions{}
-		err = options.updateIdentityAgentFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+	for i, resourceObject := range resourceObjects {
+		if err := updateResource(cmd, mode, handlers[i], resourceObject); err != nil {
+			if len(resourceObjects) > 1 {
+				return errorsx.G11NError("Unable to update the '%s' resource in '%s'; err=%v", handlers[i].Kind, resourceObject.Source(), err)
+			}
 
-	case resource.ResourceTypePrefix + "PersonalCert":
-		options := &personalCertOptions{}
-		err = options.updatePersonalCertFromDataMap(cmd, resourceObject.Data.(map[string]interface{}))
+			return err
+		}
 	}
 
-	return err
+	return nil
 }
 
-func (o *options) readFile(cmd *cobra.Command) (*resource.ResourceObject, error) {
+// updateResource replaces the resource found on the tenant. In a server dry run,
+// the data is only checked.
+func updateResource(cmd *cobra.Command, mode string, handler *resource.KindHandler, resourceObject *resource.ResourceObject) error {
 	ctx := cmd.Context()
 	vc := contextx.GetVerifyContext(ctx)
 
-	resourceObject := &resource.ResourceObject{}
-	if err := resourceObject.LoadFromFile(cmd, o.file, ""); err != nil {
-		vc.Logger.Errorf("unable to read file contents into resource object; err=%v", err)
-		return nil, err
+	if mode == dryrun.Server {
+		if err := handler.Validate(ctx, resourceObject); err != nil {
+			return err
+		}
+	}
+
+	current, err := handler.Find(ctx, resourceObject)
+	if err != nil {
+		vc.Logger.Errorf("unable to look up the resource; kind=%s, err=%v", handler.Kind, err)
+		return err
+	}
+
+	name := handler.Name(resourceObject)
+	if current == nil {
+		return errorsx.G11NError("%s '%s' not found.", handler.Kind, name)
+	}
+
+	if mode == dryrun.Server {
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' is valid and exists", handler.Kind, name)+dryrun.Suffix(mode))
+		return nil
 	}
 
-	return resourceObject, nil
+	if err := handler.Update(ctx, current, resourceObject); err != nil {
+		vc.Logger.Errorf("unable to update the resource; kind=%s, id=%s, err=%v", handler.Kind, current.Metadata.UID, err)
+		return err
+	}
+
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%s '%s' updated", handler.Kind, name)+dryrun.Suffix(mode))
+	return nil
 }
@@ -3,7 +3,6 @@ package replace
 import (
 	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/authentication"
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
@@ -12,6 +11,7 @@ import (
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -20,11 +20,12 @@ import (
 const (
 	signInOptionsUsage         = `sign-in-options [options]`
 	signInOptionsMessagePrefix = "UpdateSignInOptions"
-	signInOptionsEntitlements  = "Manage identitySources"
 	signInOptionsResourceName  = "identitySourceSignIn"
 )
 
 var (
+	signInOptionsEntitlements = config.Entitlements{config.EntitlementManageIdentitySources}
+
 	signInOptionsShortDesc = cmdutil.TranslateShortDesc(signInOptionsMessagePrefix, "Update sign-in options for an identity provider.")
 	signInOptionsLongDesc  = templates.LongDesc(cmdutil.TranslateLongDesc(signInOptionsMessagePrefix, `
 		Update sign-in options for an identity provider. This includes enabling/disabling sign-in options for admins and end users, including QR code and FIDO2 authentication.
@@ -94,7 +95,7 @@ func (o *signInOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *signInOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+" "+signInOptionsEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+signInOptionsEntitlements.String())
 		return nil
 	}
 
@@ -108,7 +109,16 @@ func (o *signInOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"SignInOptions", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), signInOptionsEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -120,9 +130,8 @@ func (o *signInOptions) updateSignInOptions(cmd *cobra.Command) error {
 	ctx := cmd.Context()
 	vc := contextx.GetVerifyContext(ctx)
 
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -160,29 +169,3 @@ func (o *signInOptions) updateSignInOptionsWithData(cmd *cobra.Command, data []b
 	cmdutil.WriteString(cmd, "Sign-in options updated successfully")
 	return nil
 }
-
-func (o *signInOptions) updateSignInOptionsFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	signInOptions := &authentication.IdentitySource{}
-	b, err := json.Marshal(data)
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, signInOptions); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to Sign-in Options; err=%v", err)
-		return err
-	}
-
-	client := authentication.NewIdentitySourceClient()
-	if err := client.UpdateSignInOptions(ctx, signInOptions); err != nil {
-		vc.Logger.Errorf("unable to update the Sign-in Options; err=%v, signInOptions=%+v", err, signInOptions)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "Sign-in options updated successfully")
-	return nil
-}
@@ -3,13 +3,13 @@ package replace
 import (
 	"encoding/json"
 	"io"
-	"os"
 
 	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
 	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 
@@ -20,11 +20,12 @@ import (
 const (
 	userUsage         = `user [options]`
 	userMessagePrefix = "UpdateUser"
-	userEntitlements  = "Manage users"
 	userResourceName  = "user"
 )
 
 var (
+	userEntitlements = config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups}
+
 	userShortDesc = cmdutil.TranslateShortDesc(userMessagePrefix, "Update a user resource.")
 
 	userLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(userMessagePrefix, `
@@ -104,7 +105,7 @@ func (o *userOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *userOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+userEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+userEntitlements.String())
 		return nil
 	}
 
@@ -122,7 +123,16 @@ func (o *userOptions) Run(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
+	if err != nil {
+		return err
+	}
+
+	if mode != dryrun.None {
+		return updateFileDryRun(cmd, o.config, mode, o.file, resource.ResourceTypePrefix+"User", "")
+	}
+
+	_, err = o.config.SetAuthToContext(cmd.Context(), userEntitlements...)
 	if err != nil {
 		return err
 	}
@@ -131,13 +141,9 @@ func (o *userOptions) Run(cmd *cobra.Command, args []string) error {
 }
 
 func (o *userOptions) updateUser(cmd *cobra.Command) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
 	// read the file
-	b, err := os.ReadFile(o.file)
+	b, err := resource.ReadDataFile(cmd, o.file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", o.file, err)
 		return err
 	}
 
@@ -164,31 +170,3 @@ func (o *userOptions) updateUserWithData(cmd *cobra.Command, data []byte) error
 	cmdutil.WriteString(cmd, "User updated successfully")
 	return nil
 }
-
-func (o *userOptions) updateUserFromDataMap(cmd *cobra.Command, data map[string]interface{}) error {
-	ctx := cmd.Context()
-	vc := contextx.GetVerifyContext(ctx)
-
-	// unmarshal to user object
-	user := &directory.UserPatchRequest{}
-	b, err := json.Marshal(data)
-
-	if err != nil {
-		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
-		return err
-	}
-
-	if err := json.Unmarshal(b, user); err != nil {
-		vc.Logger.Errorf("unable to unmarshal to a user; err=%v", err)
-		return err
-	}
-
-	client := directory.NewUserClient()
-	if err := client.UpdateUser(ctx, user.UserName, &user.SCIMPatchRequest.Operations); err != nil {
-		vc.Logger.Errorf("unable to update the user; err=%v, user=%+v", err, user)
-		return err
-	}
-
-	cmdutil.WriteString(cmd, "User updated successfully")
-	return nil
-}
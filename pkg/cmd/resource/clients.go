@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"context"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/applications"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/authentication"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/branding"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/integrations"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
+)
+
+// The SDK clients are created with the HTTP client in the context, so that the
+// requests that would change the tenant are intercepted in a dry run. See
+// dryrun.WithClient.
+
+// NewAttributeClient returns an attribute client that uses the HTTP client in the context.
+func NewAttributeClient(ctx context.Context) *directory.AttributeClient {
+	client := directory.NewAttributeClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewUserClient returns a user client that uses the HTTP client in the context.
+func NewUserClient(ctx context.Context) *directory.UserClient {
+	client := directory.NewUserClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewGroupClient returns a group client that uses the HTTP client in the context.
+func NewGroupClient(ctx context.Context) *directory.GroupClient {
+	client := directory.NewGroupClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewAccessPolicyClient returns an access policy client that uses the HTTP client in the context.
+func NewAccessPolicyClient(ctx context.Context) *security.PolicyClient {
+	client := security.NewAccessPolicyClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewIdentitySourceClient returns an identity source client that uses the HTTP client in the context.
+func NewIdentitySourceClient(ctx context.Context) *authentication.IdentitySourceClient {
+	client := authentication.NewIdentitySourceClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewAPIClient returns an API client that uses the HTTP client in the context.
+func NewAPIClient(ctx context.Context) *security.APIClient {
+	client := security.NewAPIClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewApplicationClient returns an application client that uses the HTTP client in the context.
+func NewApplicationClient(ctx context.Context) *applications.ApplicationClient {
+	client := applications.NewApplicationClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewIdentityAgentClient returns an identity agent client that uses the HTTP client in the context.
+func NewIdentityAgentClient(ctx context.Context) *integrations.IdentityAgentClient {
+	client := integrations.NewIdentityAgentClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewPasswordPolicyClient returns a password policy client that uses the HTTP client in the context.
+func NewPasswordPolicyClient(ctx context.Context) *security.PasswordPolicyClient {
+	client := security.NewPasswordPolicyClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewPersonalCertClient returns a personal certificate client that uses the HTTP client in the context.
+func NewPersonalCertClient(ctx context.Context) *security.PersonalCertClient {
+	client := security.NewPersonalCertClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewSignerCertClient returns a signer certificate client that uses the HTTP client in the context.
+func NewSignerCertClient(ctx context.Context) *security.SignerCertClient {
+	client := security.NewSignerCertClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
+
+// NewThemeClient returns a theme client that uses the HTTP client in the context.
+func NewThemeClient(ctx context.Context) *branding.ThemeClient {
+	client := branding.NewThemeClient()
+	client.Client = dryrun.HTTPClient(ctx)
+	return client
+}
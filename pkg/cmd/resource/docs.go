@@ -0,0 +1,11 @@
+package resource
+
+import (
+	"github.com/ibm-verify/verifyctl/pkg/util/schema"
+)
+
+// typeDocs are the descriptions and allowed values of the types used for the data of
+// the resources, keyed by schema.TypeKey. They are not available using reflection,
+// so they are generated from the source of the types and the API specification of the
+// SDK using 'make generate'.
+var typeDocs = map[string]*schema.TypeDoc{}
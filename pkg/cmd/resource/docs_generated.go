@@ -0,0 +1,599 @@
+// Code generated by docgen. DO NOT EDIT.
+
+package resource
+
+import "github.com/ibm-verify/verifyctl/pkg/util/schema"
+
+func init() {
+	typeDocs = map[string]*schema.TypeDoc{
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.APIClientAdditionalConfig": {
+			Fields: map[string]string{
+				"allowedClientAssertionVerificationKeys": "A list of the allowed client assertion verification keys",
+				"clientAuthMethod":                       "The authentication method type",
+				"validateClientAssertionJti":             "A Boolean value that indicates whether or not to validate the client assertion JTI",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.APIClientConfig": {
+			Fields: map[string]string{
+				"additionalProperties": "Additional properties for the client",
+				"clientId":             "The generated client id for authorization",
+				"clientName":           "The friendly name of the client",
+				"clientSecret":         "The generated client secret for authorization",
+				"description":          "A description of the client",
+				"enabled":              "Whether or not the client can be used to generate tokens",
+				"entitlements":         "The list of entitlements assigned to the client",
+				"id":                   "The unique id for the client",
+				"ipFilterOp":           "The operation of the ip filter. The default setting is null, which means that the ip filter is disabled",
+				"ipFilters":            "The list of ips",
+				"jwkUri":               "The JSON web key URI endpoint",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.APIClientConfigIPFilterOp": {
+			Description: "The operation of the ip filter. The default setting is null, which means that the ip filter is disabled",
+			Enum:        []string{"allow", "deny"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.APIClientOverrideSettings": {
+			Fields: map[string]string{
+				"restrictScopes": "Boolean value that determines whether or not to restrict scopes",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.APIClientScopes": {
+			Fields: map[string]string{
+				"description": "A description of the scope",
+				"name":        "The name of the scope",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.Address": {
+			Fields: map[string]string{
+				"country":       "The country name component. Maximum length is 128 characters.",
+				"formatted":     "The formatted value of the address. Maximum length is 500 characters.",
+				"locality":      "The city or locality component. Maximum length is 128 characters.",
+				"postalCode":    "The postal code. Maximum length is 40 characters.",
+				"primary":       "Indicates whether this is address is the primary address for correspondence.",
+				"region":        "The region. Maximum length is 128 characters.",
+				"streetAddress": "The street address. Maximum length is 128 characters.",
+				"type":          "A label that indicates the attribute's function; for example, \"work\" or \"home\".",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.AddressType": {
+			Description: "A label that indicates the attribute's function; for example, \"work\" or \"home\".",
+			Enum:        []string{"work"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.Attribute0": {
+			Fields: map[string]string{
+				"credName":          "The name of the attribute in the login session credentials. Maximum length is 2048 characters",
+				"credNameOverrides": "Realm specific name of the attribute in the login session credentials. This property is specified in the form of key-value pairs where the key is the name of the realm and the value is the name of the attribute used to override the 'credName' property. Maximum length is 2048 characters",
+				"customProperties":  "Identifies the attribute custom properties that are attached to this attribute",
+				"datatype":          "The type of derived data that is expected for the attribute. Defaults to string. The integer datatype is used for any numerical value.",
+				"description":       "The description of the attribute. Maximum length is 2048 characters",
+				"id":                "The unique identifier for the attribute",
+				"name":              "The human-readable name of the attribute. Maximum length is 2048 characters",
+				"scope":             "Identifies whether the attribute is defined globally by the system or specific to the tenant",
+				"sourceType":        "The type of the attribute source from which the attribute value is derived",
+				"tags":              "The tags used to categorize attributes. Maximum number of tags is 25",
+				"value":             "The default value of the attribute. This value also refers to the fixed value for sourceType=\"static\". Maximum length is 2048 characters",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.Attribute0Datatype": {
+			Description: "The type of derived data that is expected for the attribute. Defaults to string. The integer datatype is used for any numerical value.",
+			Enum:        []string{"boolean", "integer", "integer[]", "string", "string[]"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.Attribute0SourceType": {
+			Description: "The type of the attribute source from which the attribute value is derived",
+			Enum:        []string{"credential", "profile", "schema", "static"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.AttributeMapping": {
+			Fields: map[string]string{
+				"attrId":      "The IBM Security Verify Cloud Directory attribute identifier.",
+				"idsAttrName": "The external identity source attribute name. Only required if identity source is not Cloud Directory.",
+				"jitpOption":  "The IBM Security Verify Cloud Directory attribute identifier.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.AttributeMappingJitpOption": {
+			Description: "The IBM Security Verify Cloud Directory attribute identifier.",
+			Enum:        []string{"ALWAYS", "CREATE", "DISABLED", "NONE"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.CICCustomGroupResponse": {
+			Fields: map[string]string{
+				"description":      "The description for the group.",
+				"groupType":        "The group type. This value is read-only.",
+				"memberStartIndex": "The start index of members that are returned on the page.",
+				"membersPerPage":   "Members per page. For large group support, this property is the count of members returned in the members array.",
+				"owners":           "A list of owners for the group. For large group support, the group ownership is automatically set and enforced when the request has restricted groups that are associated with the access token's user subject identifier.",
+				"totalMembers":     "The total number of members that are in the group.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.CICCustomGroupResponseGroupType": {
+			Description: "The group type. This value is read-only.",
+			Enum:        []string{"reserved", "standard"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.CICCustomUserResponse": {
+			Fields: map[string]string{
+				"accountExpires":          "The expiration date of the account. The value is a date and time of the form yyyy-mm-ddThh:mm:ssZ. For example, an account expiration of 2021-04-01T16:00:00Z, expires on year 2021, April 1 at 16 hundred hours GMT. When an account is expired, the account's active flag is set to false to prevent login.The process that checks for expired accounts runs every 15 minutes at the top of the hour in GMT+0 time.",
+				"customAttributes":        "The custom attributes for the user. For the GET /Users API, custom attributes can be referenced by using the fully qualified name. The schema URI is urn:ietf:params:scim:schemas:extension:ibm:2.0:User:customAttributes.scimName, where scimName is the SCIM name of the custom schema attribute that was created with the POST /Schema/attributes API.",
+				"delegate":                "The \"id\" of the entry to which approval and re-certification records assigned to this identity will be delegated.",
+				"emailVerified":           "A timestamp that indicates when the user's email was verified.",
+				"lastLogin":               "Indicates the time of the last login for the current user entry. Value is a date and time of the form yyyy-mm-ddThh:mm:ssZ.",
+				"lastLoginRealm":          "Indicates the realm used for the last login for the current user entry.",
+				"lastLoginType":           "Indicates the login type used for the last login for the current user entry.",
+				"lastMFA":                 "The last MFAs for the user.",
+				"linkedAccounts":          "The linked accounts for the user.",
+				"pwdAccountLockedTime":    "A field that indicates the timestamp at which the user's password was locked. The value of this field is in milliseconds and is read-only.",
+				"pwdChangedTime":          "Indicates the time when the password was changed for the current user entry. This value is read-only.",
+				"pwdExpirationWarned":     "A field that indicates the timestamp at which the user's password expiration is set. The value of this field is in milliseconds and is read-only.",
+				"pwdFailureTime":          "A field that indicates a list of timestamps at which the user attempted to log in with the wrong password The value of this field is in milliseconds and is read-only.",
+				"pwdGraceUseTime":         "A field that indicates a list of timestamps at which the user attempted to see extended or grace time. The value of this field is in milliseconds and is read-only.",
+				"pwdReset":                "Indicates whether the password is reset for the current user entry. This value is read-only.",
+				"realm":                   "The realm to which the user belongs. It is always \"cloudIdentityRealm\" for non-federated users.",
+				"twoFactorAuthentication": "Indicates whether two factory authentication is required. It defaults to \"false\" if not provided.",
+				"unqualifiedUserName":     "An unqualified, federated user name. This field is read-only.",
+				"userCategory":            "The user category.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.CICCustomUserResponseUserCategory": {
+			Description: "The user category.",
+			Enum:        []string{"federated", "regular"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.CustomAttribute": {
+			Fields: map[string]string{
+				"name":   "The SCIM name of the custom attribute. The SCIM name for a custom schema attribute is defined for the tenant by using the POST /Schema/attributes API.",
+				"values": "The values of the custom attribute. Maximum length is 1000 characters.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.EmailAddress": {
+			Fields: map[string]string{
+				"type":  "A label that indicates the attribute function; for example, \"work\". Only a single email is allowed.",
+				"value": "The e-mail addresses for the user. The value is canonicalized by the service provider. For example, bjensen@example.com instead of bjensen@EXAMPLE.COM. Must be RFC 2822 compliant. Maximum length is 128 characters.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.EmailAddressType": {
+			Description: "A label that indicates the attribute function; for example, \"work\". Only a single email is allowed.",
+			Enum:        []string{"work"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.EnterpriseUser": {
+			Fields: map[string]string{
+				"department":     "Identifies the name of the department. Maximum length is 128 characters.",
+				"employeeNumber": "A string identifier, typically numeric or alphanumeric, that is assigned to a person. Typically the number is based on the order of hire. Maximum length is 128 characters.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.Function": {
+			Fields: map[string]string{
+				"custom": "The custom function",
+				"name":   "The function name",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.GroupMembersResponse": {
+			Fields: map[string]string{
+				"$ref":              "A sub-attr required by the SCIM specification the contains the URI of the SCIM resource.",
+				"active":            "Valid for user type members only. A Boolean value that indicates the user's administrative status. The definitive meaning of this attribute is determined by the service provider. For example, a value of true indicates that the user is, for example, able to log in, while a value of false indicates that the user's account has been suspended. If not specified, the value defaults to true.",
+				"addresses":         "Valid for user type members only. A list of addresses that are associated with the user.",
+				"displayName":       "The name of the user or group that is displayed to users. Each member returned may include a non-empty displayName value. For a user type member, typically it is the full name of the user that is being described, for example, Babs Jensen or Ms. Barbara J Jensen. However, if that information is unavailable, a username or handle can be used, for example, bjensen. The value is the primary textual label by which this user is normally displayed by the service provider when presenting information to users.",
+				"emails":            "Valid for user type members only. A list of email addresses that are associated with the user.",
+				"externalId":        "Valid for user type members only. A unique identifier for the resource that is defined by the provisioning client. It identifies a resource between the provisioning client and the service provider. The client can use a filter to locate the resource with the identifier from the provisioning domain.",
+				"id":                "The unique identifier for the resource as defined by the service. This attribute is read-only and is sent by the service. Any value that is specified for this attribute in the JSON POST or PUT request payload is ignored.",
+				"phoneNumbers":      "Valid for user type members only. A list of phone numbers that are associated with the user.",
+				"preferredLanguage": "Valid for user type members only. The language code identifying the preferred language of this identity, for example, en-us or fr-ca.",
+				"title":             "Valid for user type members only. The user's title, such as \"Vice President\".",
+				"type":              "The type of group member.",
+				"userName":          "Valid for user type members only. The unique identifier for the user that is typically used by the user to directly authenticate to the service provider. It is often displayed to the user as their unique identifier within the system (as opposed to the id or externalId attributes, which are generally opaque and not user-friendly identifiers). Each user must include a non-empty userName value. This identifier must be unique across the service consumer's entire set of users. It must be a stable ID that does not change when the same user is returned in subsequent requests.",
+				"value":             "A sub-attr required by the SCIM specification that contains the \"id\" of the SCIM resource.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.GroupMembersResponseType": {
+			Description: "The type of group member.",
+			Enum:        []string{"group", "user"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.GroupOwner": {
+			Fields: map[string]string{
+				"$ref":        "The URI of the SCIM resource representing the user. This value is readonly.",
+				"displayName": "The display name of the user. This value is readonly.",
+				"value":       "The id of the group owner",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.GroupResponseV2": {
+			Fields: map[string]string{
+				"bookmark":    "An opaque string that is used by the system to get the next 2500 members of this group. The existence of this element in the GET /v2.0/Groups/{id} response payload indicates that more members exist that were not returned in the call. To get the next set of members, the caller makes an additional call to the same endpoint, and passes the bookmark value as a query parameter named \"nextPage\". For example ?nextPage=XASDGAJDGKAWHGI=. The caller can continue to make calls to the endpoint until no bookmark element are returned in the response payload, which indicates that all group members were returned. A bookmark is generated from a membershipType query. The bookmark feature is available for tenants that have large group support enabled.",
+				"displayName": "A human-readable name for the group.",
+				"externalId":  "Identifier of the Group resource as defined by the provisioning client.",
+				"id":          "The unique identifier for the resource as defined by the service. This attribute is read-only and will be sent by the service. Any value that is specified for this attribute in the JSON POST or PUT request payload is ignored.",
+				"members":     "A list of members that belong to this group. If the group has more than 10,000 members, then this array is empty, unless large group support is enabled for the tenant. With large group support enabled, each call to the GET /v2.0/Groups/{id} endpoint returns at most 2,500 members of the group. If more members of the group exist that were not returned, a bookmark is returned in the response. The bookmark is used to get the next set of group members. See the \"bookmark\" property for more details.",
+				"schemas":     "An array of strings that contain the URIs that indicate the namespaces of the SCIM schemas that define the attributes in the current JSON structure. The schemas \"urn:ietf:params:scim:schemas:core:2.0:Group\" and \"urn:ietf:params:scim:schemas:extension:ibm:2.0:Group\" are returned in the response.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.Groups": {
+			Fields: map[string]string{
+				"$ref":        "A sub-attr required by the SCIM specification the contains the URI of the SCIM resource the user belongs to.",
+				"displayName": "The display name of the group that the user belongs to.",
+				"id":          "The identifier of the group that the user belongs to.",
+				"value":       "A sub-attr required by the SCIM specification that contains the \"id\" of the SCIM resource the user belongs to.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.IdentitySourceInstancesData": {
+			Fields: map[string]string{
+				"attributeMappings": "A set of attribute mappings that are associated with the identity source instance. It is an array of attribute mappings that are not required but an optional input for certain runtime login flows. The properties and the number of properties differe with the provider type.",
+				"enabled":           "A Boolean flag that indicates whether this instance is enabled or disabled. Only enabled identity source instances are displayed on the login selection page.",
+				"id":                "This is the unique ID for this identity source.",
+				"instanceName":      "The instance name to be displayed on login selection page.",
+				"predefined":        "This property is currently not in use. We do not support plugging-in any other custom types of identity providers. However, the purpose was to indicate that this instance is special; it neither be created and nor deleted.",
+				"properties":        "A set of properties that are associated with the identity source instance. It is an array of properties of identity source instance that are required to perform the runtime login flow. The properties and the number of properties differ with the provider type.",
+				"sourceTypeId":      "The numeric identifier of identity provider type.",
+				"status":            "A string label that indicates whether this instance is configured. If specified, this property is ignored during creation.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.IdentitySourceInstancesDataStatus": {
+			Description: "A string label that indicates whether this instance is configured. If specified, this property is ignored during creation.",
+			Enum:        []string{"configured", "unconfigured"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.IdentitySourceInstancesPropertiesData": {
+			Fields: map[string]string{
+				"key":       "Property key.",
+				"sensitive": "Indicates whether this property holds any secrets that should not be disclosed.<br> If the property is sensitive, then the property value will be masked out with asterisk characters.",
+				"value":     "Property value. Secret values will be masked out with asterisk characters.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.LastMFA": {
+			Fields: map[string]string{
+				"type":  "The type of MFA",
+				"value": "The value for this type of MFA",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.LinkedAccount": {
+			Fields: map[string]string{
+				"externalId": "The ID of a user's external account The maximum length, in combination with realm, is 239 characters.",
+				"realm":      "The realm name of the user's external account The maximum length, in combination with externalId, is 239 characters.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.Manager": {
+			Fields: map[string]string{
+				"$ref":        "The URI of the SCIM resource representing the user's manager. This value is readonly.",
+				"displayName": "The display name of the user's manager. This value is readonly.",
+				"value":       "The \"id\" of the SCIM resource representing the user's manager.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.MetaV2": {
+			Fields: map[string]string{
+				"created":      "A DateTime string that indicates when the resource was created.",
+				"deactivated":  "A readonly string that indicates why the account is deactivated. Valid values are \"api\", \"timestamp\", and \"cleanup\". The value \"api\" means that the user was deactivated by using an API. The value \"timestamp\" means that the user was deactivated by the system because the account expired. The value \"cleanup\" means that the user was deactivated by the system during account cleanup processing.",
+				"lastModified": "A DateTime string that indicates when the resource was last modified.",
+				"location":     "The URI of the resource that is being returned.",
+				"resourceType": "The field that indicates the type of resource.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.MetaV2Deactivated": {
+			Description: "A readonly string that indicates why the account is deactivated. Valid values are \"api\", \"timestamp\", and \"cleanup\". The value \"api\" means that the user was deactivated by using an API. The value \"timestamp\" means that the user was deactivated by the system because the account expired. The value \"cleanup\" means that the user was deactivated by the system during account cleanup processing.",
+			Enum:        []string{"api", "cleanup", "timestamp"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.Name": {
+			Fields: map[string]string{
+				"familyName": "The family name of the user, or the last name in most Western languages. For example, Jensen is the family name from the full name Ms. Barbara J Jensen, PhD. Maximum length is 80 characters.",
+				"formatted":  "The full name of the user that includes all user names, middle names, and suffixes, that are formatted for display. This value is returned by the service provider if it is not part of the POST or PUT payloads. If the POST or PUT JSON payload contains the value for this attribute, the value in the payload takes precedence. Maximum length is 240 characters.",
+				"givenName":  "The given name of the user, or first name in most Western languages. For example, Barbara is the given name from the full name Ms. Barbara J Jensen, PhD. Maximum length is 80 characters.",
+				"middleName": "The middle name(s) of the user. Maximum length is 80 characters.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.OnpremAgentConfigReference": {
+			Description: "The entity reference details.",
+			Fields: map[string]string{
+				"id":   "The unique identifier of the resource that the agent configuration is referencing",
+				"ref":  "The type of reference that the agent configuration is referencing",
+				"type": "The type of resource that the agent configuration is referencing",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.OnpremAgentConfiguration": {
+			Description: "The agent configuration.",
+			Fields: map[string]string{
+				"apiClients":        "The list of api clients which are permitted to access this configuration",
+				"authnCacheTimeout": "Time in minutes to cache authentications. Default is no caching",
+				"certLabel":         "The certificate label used to encrypt modules data, default certificate used if not supplied",
+				"description":       "The description of the agent configuration",
+				"heartbeat":         "How often the agent should emit a heartbeat",
+				"id":                "The unique identifier of the agent configuration",
+				"modules":           "The associated modules with the agent configuration",
+				"name":              "The name of the agent configuration",
+				"purpose":           "The purpose of the agent configuration",
+				"references":        "The entities referenced by this configuration.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.OnpremAgentConfigurationPurpose": {
+			Description: "The purpose of the agent configuration",
+			Enum:        []string{"AUTHN", "CERTIFICATION", "EXTAUTHN", "EXTERNAL", "LDAPAUTH", "PROV"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.PhoneNumber": {
+			Fields: map[string]string{
+				"type":  "A label that indicates the attribute's function; for example, \"work\" or \"home\".",
+				"value": "A list of phone numbers that are associated with the user. The value is be canonicalized by the service provider according to format in RFC3966, for example, \"tel:+1-201-555-0123\". Canonical type values are work, home, mobile, fax, and pager. Maximum length is 32 characters.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.PhoneNumberType": {
+			Description: "A label that indicates the attribute's function; for example, \"work\" or \"home\".",
+			Enum:        []string{"fax", "home", "mobile", "pager", "work"},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.PostEval": {
+			Fields: map[string]string{
+				"custom": "A custom rule for transforming. This will tranform the attribute mapping. Only one of 'id' or 'custom' can be set on an attribute mapping.",
+				"id":     "A valid attribute function. This will tranform the attribute mapping. Only one of 'id' or 'custom' can be set on an attribute mapping.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.ProfileAttribute": {
+			Fields: map[string]string{
+				"name": "The name of the attribute in the application profile.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.SchemaAttribute": {
+			Fields: map[string]string{
+				"attributeName":   "The attribute name in the schema that is associated with the attribute source",
+				"customAttribute": "The flag that indicates whether this is a custom attribute",
+				"name":            "The unique name for the attribute in the Cloud Directory schema. For custom schema attributes, the names are predefined and named customAttribute1 through customAttribute150",
+				"scimName":        "The SCIM name that is associated with the schema attribute",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/internal/openapi.UserResponseV2": {
+			Fields: map[string]string{
+				"active":            "A Boolean value that indicates the user's administrative status. The definitive meaning of this attribute is determined by the service provider. For example, a value of true indicates that the user can, log in, while a value of false indicates that the user's account has been suspended. If not specified, the value defaults to true.",
+				"addresses":         "A list of addresses that are associated with the user.",
+				"displayName":       "The name of the user that is displayed to users. Each user returned may include a non-empty displayName value. Typically it is the full name of the user being described, for example, Babs Jensen or Ms. Barbara J Jensen. However, if that information is unavailable, a username or handle can be used, for example, bjensen. The value is the primary textual label by which this user is normally displayed by the service provider when presenting information to users.",
+				"emails":            "A list of email addresses that are associated with the user. Only one is supported.",
+				"externalId":        "A unique identifier for the resource that is defined by the provisioning client. It identifies a resource between the provisioning client and the service provider. The client can use a filter to locate the resource with an identifier from the provisioning domain.",
+				"groups":            "The list of groups that the user belongs to. Any value that is specified for this attribute in the JSON POST or PUT request payload is ignored. Group membership is managed by using the /Groups API.",
+				"id":                "The unique identifier for the resource as defined by the service. This attribute is read-only and ise sent by the service. Any value that is specified for this attribute in the JSON POST or PUT request payload is ignored.",
+				"phoneNumbers":      "A list of phone numbers that are associated with the user.",
+				"preferredLanguage": "The language code identifying the preferred language of this identity, for example, en-us or fr-ca.",
+				"schemas":           "An array of strings that contain the URIs that indicate the namespaces of the SCIM schemas that define the attributes in the current JSON structure. The schemas \"urn:ietf:params:scim:schemas:core:2.0:User\", \"urn:ietf:params:scim:schemas:extension:ibm:2.0:User\" and \"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User\" are returned in the response.",
+				"title":             "The user's title, such as \"Vice President.\"",
+				"userName":          "The unique identifier for the user that is typically used by the user to directly authenticate to the service provider. It is often displayed to the user as their unique identifier within the system (as opposed to the id or externalId attributes, which are generally opaque and not user-friendly identifiers). Each user must include a non-empty userName value. This identifier must be unique across the service consumer's entire set of users. It must be a stable ID that does not change when the same user is returned in subsequent requests.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.APIAccessClient": {
+			Fields: map[string]string{
+				"accessTokenLifetime": "Access token lifetime seconds.",
+				"accessTokenType":     "Type of token",
+				"clientId":            "Unique identifier for a client. Auto generated on save.",
+				"clientName":          "Display client name.",
+				"defaultEntitlements": "List of entitlement strings.",
+				"enabled":             "If true, client is enabled.",
+				"ipFilterOp":          "Operator to filter ip (allow/deny).",
+				"ipFilters":           "List and/or range of IPs to filter ip based on ipFilterOp.",
+				"jwkUri":              "The URI where the relying party publishes its public keys in JSON Web Keys (JWKs) format.",
+				"jwtSigningAlg":       "JWT signing algorithm.",
+				"restrictScopes":      "Restrict the scopes for application api access.",
+				"scopes":              "List of scopes.",
+				"signKeyLabel":        "Signature key label.",
+			},
+			FieldEnums: map[string][]string{
+				"accessTokenType": []string{"default", "jwt"},
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.AdaptiveAuthentication": {
+			Fields: map[string]string{
+				"licenseData": "License Data",
+				"platform":    "platform name",
+				"storageLink": "Storage link",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.Application": {
+			Fields: map[string]string{
+				"adaptiveAuthentication": "Adaptive authentication data for devportal applications",
+				"apiAccessClients":       "List of API access clients for application",
+				"applicationState":       "True if application is saved and False if it is in Draft State.",
+				"approvalRequired":       "Boolean flag representing whether approval request will be sent when end user request access",
+				"attributeMappings":      "List[AttributeMapBean], collection of attributeMapping.",
+				"customIcon":             "Custom Icon file name if any",
+				"description":            "description about application",
+				"identitySources":        "List[String], a collection of application IdentitySources.",
+				"name":                   "Name of the Entity.",
+				"owners":                 "List[String] , a collection of owners of this application",
+				"providers":              "Provider Bean containing relevant attributes",
+				"provisioningMode":       "Mode of provisioning for example SAMLJIT etc",
+				"signonState":            "True if Sign On is Enabled and False if it is in Disabled.",
+				"target":                 "Map[String, Boolean] , a collection of target of this application.",
+				"templateId":             "ID of the template for this application",
+				"visibleOnLaunchpad":     "Visibility flag for the application on launchpad.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.AttributeMapping": {
+			Fields: map[string]string{
+				"outboundTracking": "boolean flag representing whether any change in the attribute's value should be percolated to the target",
+				"sourceId":         "Unique identifier of the attribute",
+				"targetName":       "Name of the attribute",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.Bookmark": {
+			Fields: map[string]string{
+				"bookmarkUrl": "string containing URL of Bookmark",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.Customization": {
+			Fields: map[string]string{
+				"themeId": "Theme identifier for the application",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.DevportalSettings": {
+			Fields: map[string]string{
+				"attributeMappings":          "List[AttributeMapBean], collection of attributeMapping settings for the developer portal application .",
+				"extendedProperties":         "Map<String,String> a map containing Devportal extended properties.",
+				"identitySources":            "List[String], a collection of application IdentitySources settings for the developer portal application.",
+				"sendAllKnownUserAttributes": "Boolean flag setting to indicate to return all supported claims for the developer portal application.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.GrantTypes": {
+			Fields: map[string]string{
+				"authorizationCode": "Authorization Code.",
+				"clientCredentials": "Allow client credentials grant type to be configured",
+				"deviceFlow":        "Device Flow.",
+				"implicit":          "Implicit",
+				"jwtBearer":         "JWT bearer token.",
+				"policyAuth":        "Policy driven authentication token.",
+				"ropc":              "Resource owner password credentials.",
+				"tokenExchange":     "Token Exchange",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.JWTBearerProperties": {
+			Fields: map[string]string{
+				"identitySource": "Identity source of user for JWT Bearer properties.",
+				"userIdentifier": "User Identifier for JWT Bearer grant type.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.ManageNameIDService": {
+			Fields: map[string]string{
+				"url": "URL value.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.OIDC": {
+			Fields: map[string]string{
+				"applicationUrl":          "URL which will be use to trigger Single-Sign-On flow.",
+				"consentAction":           "Consent action",
+				"entitlements":            "OIDC application entitlements",
+				"requirePkceVerification": "PKCE verification",
+				"restrictEntitlements":    "Flag to restrict oidc entitlements",
+			},
+			FieldEnums: map[string][]string{
+				"consentAction":           []string{"never_prompt", "always_prompt"},
+				"requirePkceVerification": []string{"false", "true"},
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.OIDCProperties": {
+			Fields: map[string]string{
+				"accessTokenExpiry":          "Access token lifetime, in seconds",
+				"additionalConfig":           "Additional OIDC configurations",
+				"clientId":                   "Unique identifier for a client.",
+				"clientSecret":               "Client Secret. Will be auto-generated if this parameter is not specified.",
+				"consentType":                "The type of consent to be generated for this application. New applications will automatically use 'dpcm' advanced privacy consent.This is a read only attribute, if specified in the POST and PUT calls, it will be ignored.",
+				"doNotGenerateClientSecret":  "Boolean flag to disable auto generation of OIDC client secret.",
+				"generateRefreshToken":       "Boolean flag to indicate that lifetime of authorization grant must be set.",
+				"idTokenEncryptAlg":          "ID token key management encryption algorithm. List of algorithms (id_token_encryption_alg_values_supported) available at /oidc/endpoint/{definition_id}/.well-known/openid-configuration",
+				"idTokenEncryptEnc":          "ID token content encryption algorithm. List of algorithms (id_token_encryption_enc_values_supported) available at /oidc/endpoint/{definition_id}/.well-known/openid-configuration",
+				"idTokenEncryptKey":          "ID token encryption public key label.",
+				"idTokenSigningAlg":          "ID token signing algorithm.",
+				"jwksUri":                    "Relying party URL. If not specified public key used for signature verification.",
+				"redirectUris":               "List of redirect URIs.",
+				"refreshTokenExpiry":         "Lifetime of authorization grant. Required only when generateRefreshToken is set",
+				"renewRefreshToken":          "Should renew refresh token, true or false",
+				"renewRefreshTokenExpiry":    "Renew refresh token expiry value.",
+				"sendAllKnownUserAttributes": "Boolean flag to indicate to return all supported claims.",
+				"signIdToken":                "Boolean flag to indicate that a key label must be specified to perform signing.",
+				"signingCertificate":         "Key label used to perform the signing. Required only when signIdToken is set.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.SAML": {
+			Fields: map[string]string{
+				"additionalProperties":     "List, a collection of additional config for saml configuration.",
+				"assertionConsumerService": "List, a collection of assertion consumer service url details.",
+				"justInTimeProvisioning":   "Boolean indicating if SAML JIT is enabled.",
+				"singleLogoutService":      "List, a collection of single logout service url details.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.SAMLProperties": {
+			Fields: map[string]string{
+				"assertionConsumerServiceUrl":      "Service provider's assertion consumer service url.",
+				"blockEncryptionAlgorithm":         "Algorithm to be used for encryption.",
+				"companyName":                      "Name of the service provider company.",
+				"defaultNameIdFormat":              "Default nameId format.",
+				"encryptAssertion":                 "Boolean flag to indicate whether the SAML assertion will be encrypted.",
+				"encryptionKeyIdentifier":          "Identifier of the certificate that is used for encrypting the response.",
+				"generateUniqueID":                 "Boolean flag to indicate whether the \"UniqueID\" is used in combination with providerId for checking uniqueness at the federation level.",
+				"ici_reserved_subjectNameID":       "Attribute source identifier.",
+				"includeAllAttributes":             "Boolean flag to indicate whether all known attributes of the user will be included in the SAML assertion.",
+				"providerId":                       "Unique identifier of the service provider. Also known as Entity Id or Issuer Id.",
+				"sessionNotOnOrAfter":              "SAML configuration for SessionNotOnOrAfter",
+				"signAuthnResponse":                "Boolean flag to indicate whether IDP will sign the SAML authentication response sent to the service provider.",
+				"signatureAlgorithm":               "Signature algorithm to be used for signing the SAML response / assertion. Supported values are RSA-SHA1, RSA-SHA256, RSA-SHA512, ECDSA-SHA256, ECDSA-SHA384, ECDSA-SHA512",
+				"signatureValidationKeyIdentifier": "Identifier of the certificate that is used for validating the request signature.",
+				"signingKeyIdentifier":             "Value of the Signing certificate.",
+				"uniqueID":                         "Unique id generated.",
+				"validateAuthnRequest":             "Boolean flag to indicate whether the IDP should validate the SAML request signature.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.SSO": {
+			Fields: map[string]string{
+				"domainName":             "Domain Name for SSO",
+				"idpInitiatedSSOSupport": "string representation for IDP initiated SSO Support",
+				"spssoUrl":               "String containing SPSSO URL",
+				"targetUrl":              "String containing Target URL",
+				"userOptions":            "string representation for User Options",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.Token": {
+			Fields: map[string]string{
+				"accessTokenType":   "Type of token",
+				"attributeMappings": "List of attribute maps",
+				"audiences":         "List of audiences",
+			},
+			FieldEnums: map[string][]string{
+				"accessTokenType": []string{"default", "jwt"},
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/applications.WSFedProperties": {
+			Fields: map[string]string{
+				"additionalProperties": "List of additional WSFed properties.",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/security.AccessPolicyMeta": {
+			Description: "Meta structure",
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/security.Attributes": {
+			Description: "Attribute represents an attribute within a condition",
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/security.Condition": {
+			Description: "Condition represents a policy condition",
+			Fields: map[string]string{
+				"attributes": "Nested attributes",
+				"enabled":    "Nullable boolean",
+				"opCode":     "Nullable string",
+			},
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/security.Policy": {
+			Description: "Policy structure",
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/security.Result": {
+			Description: "Result structure",
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/security.Rule": {
+			Description: "Rule structure",
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/security.ServerSideAction": {
+			Description: "ServerSideAction structure",
+		},
+		"github.com/ibm-verify/verify-sdk-go/pkg/config/security.Validations": {
+			Description: "Validations structure",
+		},
+		"github.com/ibm-verify/verifyctl/pkg/cmd/resource.Overlay": {
+			Description: "Overlay is the data of an overlay. It includes the resources in other files, such as a base definition shared by several tenants, and patches them, so that only the differences are kept for each tenant.",
+			Fields: map[string]string{
+				"patches":   "Patches are applied in order to the resources read with the overlay.",
+				"resources": "Resources are the files or directories that hold the resources to include. Relative paths are relative to the directory of the overlay file.",
+			},
+		},
+		"github.com/ibm-verify/verifyctl/pkg/cmd/resource.OverlayPatch": {
+			Description: "OverlayPatch changes the data of a resource using either a JSON merge patch or a JSON patch.",
+			Fields: map[string]string{
+				"jsonPatch":  "JSONPatch is a JSON patch, as defined in RFC 6902.",
+				"mergePatch": "MergePatch is a JSON merge patch, as defined in RFC 7396.",
+				"target":     "Target identifies the resource to change.",
+			},
+		},
+		"github.com/ibm-verify/verifyctl/pkg/cmd/resource.OverlayTarget": {
+			Description: "OverlayTarget identifies a resource by its kind and name.",
+			Fields: map[string]string{
+				"kind": "Kind is the kind of the resource, such as 'IBMVerifyApplication'.",
+				"name": "Name is the name of the resource in the metadata or the data.",
+			},
+		},
+		"github.com/ibm-verify/verifyctl/pkg/cmd/resource.themeData": {
+			Description: "themeData is the data of a theme, which has no type in the SDK.",
+			Fields: map[string]string{
+				"description": "Description describes the theme.",
+				"files":       "Files is the base64-encoded zip file with the customized templates.",
+				"name":        "Name is the name of the theme.",
+			},
+		},
+		"github.com/ibm-verify/verifyctl/pkg/util/patch.Operation": {
+			Description: "Operation is an operation of a JSON patch, as defined in RFC 6902.",
+			Fields: map[string]string{
+				"from":  "From is the JSON pointer to the value that is moved or copied.",
+				"op":    "Op is the operation: 'add', 'remove', 'replace', 'move', 'copy' or 'test'.",
+				"path":  "Path is the JSON pointer to the value the operation changes or tests.",
+				"value": "Value is the value that is added or tested.",
+			},
+		},
+	}
+}
@@ -0,0 +1,1611 @@
+package resource
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/applications"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/authentication"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/branding"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/directory"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/integrations"
+	"github.com/ibm-verify/verify-sdk-go/pkg/config/security"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	"github.com/ibm-verify/verifyctl/pkg/module"
+	xhttp "github.com/ibm-verify/verifyctl/pkg/util/http"
+	"github.com/ibm-verify/verifyctl/pkg/util/secret"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+// kindHandlers holds the handlers of the kinds that can be created or updated
+// using resource files.
+var kindHandlers = map[string]*KindHandler{}
+
+func init() {
+	for _, h := range []*KindHandler{
+		attributeHandler(),
+		userHandler(),
+		groupHandler(),
+		accessPolicyHandler(),
+		identitySourceHandler(),
+		signInOptionsHandler(),
+		apiClientHandler(),
+		applicationHandler(),
+		identityAgentHandler(),
+		passwordPolicyHandler(),
+		personalCertHandler(),
+		signerCertHandler(),
+		themeHandler(),
+	} {
+		kindHandlers[h.Kind] = h
+	}
+}
+
+func attributeHandler() *KindHandler {
+	kind := ResourceTypePrefix + "Attribute"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "name",
+		Entitlements:     config.Entitlements{config.EntitlementManageAttributes},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageAttributes, config.EntitlementReadAttributes},
+		APIVersions:      []string{"1.0"},
+		DataType:         reflect.TypeOf(directory.Attribute{}),
+		Required:         []string{"name", "sourceType"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			client := NewAttributeClient(ctx)
+			if len(id) > 0 {
+				found, err := exists(ctx, "/v1.0/attributes/"+url.PathEscape(id), "application/json")
+				if err != nil {
+					return nil, err
+				}
+
+				if found {
+					attribute, uri, err := client.GetAttribute(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+
+					return newObject(kind, id, attribute.Name, uri, attribute), nil
+				}
+			}
+
+			attributes, uri, err := client.GetAttributes(ctx, fmt.Sprintf(`name="%s"`, filterValue(name)), "", 0, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, attribute := range attributes.Attributes {
+				if attribute.Name == name {
+					return newObject(kind, stringValue(attribute.ID), attribute.Name, uri, &attribute), nil
+				}
+			}
+
+			return nil, nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			attribute := &directory.Attribute{}
+			if err := decodeData(ctx, data, attribute); err != nil {
+				return "", err
+			}
+
+			return NewAttributeClient(ctx).CreateAttribute(ctx, attribute)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			attribute := &directory.Attribute{}
+			if err := decodeData(ctx, data, attribute); err != nil {
+				return err
+			}
+
+			attribute.ID = &current.Metadata.UID
+			return NewAttributeClient(ctx).UpdateAttribute(ctx, attribute)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			client := NewAttributeClient(ctx)
+			objects := []*ResourceObject{}
+			err := readPages(func(page int, limit int) (int, int, error) {
+				attributes, uri, err := client.GetAttributes(ctx, "", "", page, limit)
+				if err != nil {
+					return 0, 0, err
+				}
+
+				for _, attribute := range attributes.Attributes {
+					objects = append(objects, newObject(kind, stringValue(attribute.ID), attribute.Name, listURI(uri), &attribute))
+				}
+
+				return len(attributes.Attributes), attributes.Total, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewAttributeClient(ctx).DeleteAttributeByID(ctx, current.Metadata.UID)
+		},
+	}
+}
+
+func userHandler() *KindHandler {
+	kind := ResourceTypePrefix + "User"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "userName",
+		Entitlements:     config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups, config.EntitlementReadUserGroups},
+		APIVersions:      []string{"1.0", "2.0"},
+		DataType:         reflect.TypeOf(directory.User{}),
+		Required:         []string{"userName"},
+		patchType:        reflect.TypeOf(directory.UserPatchRequest{}),
+		find: func(ctx context.Context, _ string, name string) (*ResourceObject, error) {
+			// users are only looked up by name
+			resources, uri, err := findSCIM(ctx, "Users", "userName", name)
+			if err != nil || len(resources) == 0 {
+				return nil, err
+			}
+
+			user := &directory.User{}
+			if err := json.Unmarshal(resources[0], user); err != nil {
+				return nil, err
+			}
+
+			return newObject(kind, user.ID, user.UserName, uri+"/"+user.ID, user), nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			user := &directory.User{}
+			if err := decodeData(ctx, data, user); err != nil {
+				return "", err
+			}
+
+			return NewUserClient(ctx).CreateUser(ctx, user)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			operations, err := updateOperations(ctx, current, data, "id", "userName", "schemas", "meta", "password")
+			if err != nil || len(operations) == 0 {
+				return err
+			}
+
+			return NewUserClient(ctx).UpdateUser(ctx, current.Metadata.Name, &operations)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			resources, _, err := listSCIM(ctx, "/v2.0/Users", "")
+			if err != nil {
+				return nil, err
+			}
+
+			// the list does not hold all the properties of the users
+			client := NewUserClient(ctx)
+			objects := []*ResourceObject{}
+			for _, resource := range resources {
+				u := &directory.User{}
+				if err := json.Unmarshal(resource, u); err != nil {
+					return nil, err
+				}
+
+				user, uri, err := client.GetUser(ctx, u.UserName)
+				if err != nil {
+					return nil, err
+				}
+
+				objects = append(objects, newObject(kind, user.ID, user.UserName, uri, user))
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewUserClient(ctx).DeleteUser(ctx, current.Metadata.Name)
+		},
+	}
+}
+
+func groupHandler() *KindHandler {
+	kind := ResourceTypePrefix + "Group"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "displayName",
+		Entitlements:     config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageUserGroups, config.EntitlementManageAllUserGroups, config.EntitlementReadUserGroups},
+		APIVersions:      []string{"1.0", "2.0"},
+		DataType:         reflect.TypeOf(directory.Group{}),
+		Required:         []string{"displayName"},
+		patchType:        reflect.TypeOf(directory.GroupPatchRequest{}),
+		normalize:        groupData,
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			client := NewGroupClient(ctx)
+			if len(id) > 0 {
+				found, err := exists(ctx, "/v2.0/Groups/"+url.PathEscape(id), "application/scim+json")
+				if err != nil {
+					return nil, err
+				}
+
+				if found {
+					group, uri, err := client.GetGroupByID(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+
+					return groupObject(kind, id, uri, group)
+				}
+			}
+
+			resources, _, err := findSCIM(ctx, "Groups", "displayName", name)
+			if err != nil || len(resources) == 0 {
+				return nil, err
+			}
+
+			// the list does not hold the members of the group
+			found := &directory.Group{}
+			if err := json.Unmarshal(resources[0], found); err != nil {
+				return nil, err
+			}
+
+			group, uri, err := client.GetGroupByID(ctx, stringValue(found.ID))
+			if err != nil {
+				return nil, err
+			}
+
+			return groupObject(kind, stringValue(found.ID), uri, group)
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			// the SDK looks up the users by the userName in 'value', so they are only
+			// checked here to report the ones that do not exist
+			if _, err := memberIDs(ctx, data["members"]); err != nil {
+				return "", err
+			}
+
+			// the SDK requires the members
+			if _, ok := data["members"]; !ok {
+				data = maps.Clone(data)
+				data["members"] = []interface{}{}
+			}
+
+			group := &directory.Group{}
+			if err := decodeData(ctx, data, group); err != nil {
+				return "", err
+			}
+
+			return NewGroupClient(ctx).CreateGroup(ctx, group)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			operations, err := updateOperations(ctx, current, data, "id", "displayName", "schemas", "meta")
+			if err != nil || len(operations) == 0 {
+				return err
+			}
+
+			// the members are replaced using the IDs of the users on the tenant
+			if _, ok := data["scimPatch"]; !ok {
+				for i, operation := range operations {
+					if operation.Path != "members" || operation.Value == nil {
+						continue
+					}
+
+					members, err := memberIDs(ctx, *operation.Value)
+					if err != nil {
+						return err
+					}
+
+					var value interface{} = members
+					operations[i].Value = &value
+				}
+			}
+
+			return NewGroupClient(ctx).UpdateGroup(ctx, current.Metadata.Name, &operations)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			resources, _, err := listSCIM(ctx, "/v2.0/Groups", "")
+			if err != nil {
+				return nil, err
+			}
+
+			// the list does not hold the members of the groups
+			client := NewGroupClient(ctx)
+			objects := []*ResourceObject{}
+			for _, resource := range resources {
+				g := &directory.Group{}
+				if err := json.Unmarshal(resource, g); err != nil {
+					return nil, err
+				}
+
+				group, uri, err := client.GetGroupByID(ctx, stringValue(g.ID))
+				if err != nil {
+					return nil, err
+				}
+
+				obj, err := groupObject(kind, stringValue(g.ID), uri, group)
+				if err != nil {
+					return nil, err
+				}
+
+				objects = append(objects, obj)
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewGroupClient(ctx).DeleteGroup(ctx, current.Metadata.Name)
+		},
+	}
+}
+
+// groupObject returns the group with its members in the form used in resource files,
+// since the IDs of the users differ between tenants.
+func groupObject(kind string, id string, uri string, group *directory.Group) (*ResourceObject, error) {
+	v, err := toGeneric(group)
+	if err != nil {
+		return nil, err
+	}
+
+	data, _ := v.(map[string]interface{})
+	return newObject(kind, id, group.DisplayName, uri, groupData(data)), nil
+}
+
+// groupData returns a copy of the data of a group with its members identified by
+// userName in 'value'. The members read from the tenant hold the ID of the user in
+// 'value' and the name in 'userName'. The properties only returned by the tenant,
+// such as '$ref' and 'display', are dropped, and the members are sorted so that the
+// order does not matter when groups are compared.
+func groupData(data map[string]interface{}) map[string]interface{} {
+	items, ok := data["members"].([]interface{})
+	if !ok {
+		return data
+	}
+
+	members := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		member := map[string]interface{}{
+			"value": m["value"],
+		}
+
+		if t, ok := m["type"]; ok {
+			member["type"] = t
+		}
+
+		if userName, _ := m["userName"].(string); len(userName) > 0 {
+			member["value"] = userName
+		}
+
+		members = append(members, member)
+	}
+
+	slices.SortStableFunc(members, func(a, b interface{}) int {
+		return strings.Compare(fmt.Sprint(a.(map[string]interface{})["value"]), fmt.Sprint(b.(map[string]interface{})["value"]))
+	})
+
+	ret := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		ret[key] = value
+	}
+
+	ret["members"] = members
+	return ret
+}
+
+// memberIDs returns the members of a group with the userName of the users replaced
+// with their IDs on the tenant. The users are looked up using the user handler.
+func memberIDs(ctx context.Context, v interface{}) ([]interface{}, error) {
+	items, _ := v.([]interface{})
+	members := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		member := make(map[string]interface{}, len(m))
+		for key, value := range m {
+			member[key] = value
+		}
+
+		if t, _ := m["type"].(string); len(t) == 0 || strings.EqualFold(t, "user") {
+			userName, _ := m["value"].(string)
+			user, err := kindHandlers[ResourceTypePrefix+"User"].find(ctx, "", userName)
+			if err != nil {
+				return nil, err
+			}
+
+			if user == nil {
+				return nil, errorsx.G11NError("The member '%s' of the group does not exist.", userName)
+			}
+
+			member["value"] = user.Metadata.UID
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+func accessPolicyHandler() *KindHandler {
+	kind := ResourceTypePrefix + "AccessPolicy"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "name",
+		Entitlements:     config.Entitlements{config.EntitlementManageAccessPolicies},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageAccessPolicies, config.EntitlementReadAccessPolicies},
+		APIVersions:      []string{"1.0", "5.0"},
+		DataType:         reflect.TypeOf(security.Policy{}),
+		Required:         []string{"name"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			client := NewAccessPolicyClient(ctx)
+			if len(id) > 0 {
+				found, err := exists(ctx, "/v5.0/policyvault/accesspolicy/"+url.PathEscape(id), "application/json")
+				if err != nil {
+					return nil, err
+				}
+
+				if found {
+					policy, uri, err := client.GetAccessPolicy(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+
+					return newObject(kind, id, policy.Name, uri, policy), nil
+				}
+			}
+
+			// the API does not filter the policies by name
+			found := ""
+			err := readPages(func(page int, limit int) (int, int, error) {
+				policies, _, err := client.GetAccessPolicies(ctx, page, limit)
+				if err != nil {
+					return 0, 0, err
+				}
+
+				for _, p := range policies.Policies {
+					if p.Name == name {
+						found = strconv.Itoa(p.ID)
+						return 0, 0, nil
+					}
+				}
+
+				return len(policies.Policies), policies.Total, nil
+			})
+			if err != nil || len(found) == 0 {
+				return nil, err
+			}
+
+			// the list does not hold the rules of the policies
+			policy, uri, err := client.GetAccessPolicy(ctx, found)
+			if err != nil {
+				return nil, err
+			}
+
+			return newObject(kind, found, policy.Name, uri, policy), nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			policy := &security.Policy{}
+			if err := decodeData(ctx, data, policy); err != nil {
+				return "", err
+			}
+
+			return NewAccessPolicyClient(ctx).CreateAccessPolicy(ctx, policy)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			policy := &security.Policy{}
+			if err := decodeData(ctx, data, policy); err != nil {
+				return err
+			}
+
+			policy.ID, _ = strconv.Atoi(current.Metadata.UID)
+			return NewAccessPolicyClient(ctx).UpdateAccessPolicy(ctx, policy)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			client := NewAccessPolicyClient(ctx)
+			ids := []string{}
+			err := readPages(func(page int, limit int) (int, int, error) {
+				policies, _, err := client.GetAccessPolicies(ctx, page, limit)
+				if err != nil {
+					return 0, 0, err
+				}
+
+				for _, p := range policies.Policies {
+					ids = append(ids, strconv.Itoa(p.ID))
+				}
+
+				return len(policies.Policies), policies.Total, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// the list does not hold the rules of the policies
+			objects := []*ResourceObject{}
+			for _, id := range ids {
+				policy, uri, err := client.GetAccessPolicy(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+
+				objects = append(objects, newObject(kind, id, policy.Name, uri, policy))
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewAccessPolicyClient(ctx).DeleteAccessPolicyByID(ctx, current.Metadata.UID)
+		},
+	}
+}
+
+func findIdentitySource(ctx context.Context, kind string, id string, name string) (*ResourceObject, error) {
+	client := NewIdentitySourceClient(ctx)
+	if len(id) > 0 {
+		found, err := exists(ctx, "/v2.0/identitysources/"+url.PathEscape(id), "application/json")
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+			identitySource, uri, err := client.GetIdentitySourceByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			return newObject(kind, id, identitySource.InstanceName, uri, identitySource), nil
+		}
+	}
+
+	// the API does not filter the identity sources by name
+	var found *ResourceObject
+	err := readPages(func(page int, limit int) (int, int, error) {
+		identitySources, uri, err := client.GetIdentitySources(ctx, "", "", page, limit)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, identitySource := range identitySources.IdentitySources {
+			if identitySource.InstanceName == name {
+				found = newObject(kind, identitySource.ID, identitySource.InstanceName, listURI(uri), &identitySource)
+				return 0, 0, nil
+			}
+		}
+
+		return len(identitySources.IdentitySources), int(identitySources.Total), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func identitySourceHandler() *KindHandler {
+	kind := ResourceTypePrefix + "IdentitySource"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "instanceName",
+		Entitlements:     config.Entitlements{config.EntitlementManageIdentitySources},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageIdentitySources, config.EntitlementReadIdentitySources},
+		APIVersions:      []string{"1.0", "2.0"},
+		DataType:         reflect.TypeOf(authentication.IdentitySource{}),
+		Required:         []string{"instanceName", "sourceTypeId"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			return findIdentitySource(ctx, kind, id, name)
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			identitySource := &authentication.IdentitySource{}
+			if err := decodeData(ctx, data, identitySource); err != nil {
+				return "", err
+			}
+
+			return NewIdentitySourceClient(ctx).CreateIdentitySource(ctx, identitySource)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			identitySource := &authentication.IdentitySource{}
+			if err := decodeData(ctx, data, identitySource); err != nil {
+				return err
+			}
+
+			identitySource.ID = current.Metadata.UID
+			return NewIdentitySourceClient(ctx).UpdateIdentitySource(ctx, identitySource.ID, identitySource)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			client := NewIdentitySourceClient(ctx)
+			objects := []*ResourceObject{}
+			err := readPages(func(page int, limit int) (int, int, error) {
+				identitySources, uri, err := client.GetIdentitySources(ctx, "", "", page, limit)
+				if err != nil {
+					return 0, 0, err
+				}
+
+				for _, identitySource := range identitySources.IdentitySources {
+					objects = append(objects, newObject(kind, identitySource.ID, identitySource.InstanceName, listURI(uri), &identitySource))
+				}
+
+				return len(identitySources.IdentitySources), int(identitySources.Total), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewIdentitySourceClient(ctx).DeleteIdentitySourceByID(ctx, current.Metadata.UID)
+		},
+	}
+}
+
+// signInOptionsHandler updates the properties of an identity source that control
+// the sign-in page. The other properties of the identity source are retained.
+func signInOptionsHandler() *KindHandler {
+	kind := ResourceTypePrefix + "SignInOptions"
+
+	// merge returns the identity source on the tenant with the properties in the data
+	merge := func(current *ResourceObject, data map[string]interface{}) (map[string]interface{}, error) {
+		options := &authentication.SignInOptions{}
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(b, options); err != nil {
+			return nil, err
+		}
+
+		v, err := toGeneric(current.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		identitySource, _ := v.(map[string]interface{})
+		properties, _ := identitySource["properties"].([]interface{})
+		for _, option := range options.Properties {
+			value, ok := option["value"].(string)
+			if !ok && option["value"] != nil {
+				value = fmt.Sprint(option["value"])
+			}
+
+			found := false
+			for _, p := range properties {
+				if property, _ := p.(map[string]interface{}); property != nil && property["key"] == option["key"] {
+					property["value"] = value
+					found = true
+				}
+			}
+
+			if !found {
+				properties = append(properties, map[string]interface{}{
+					"key":       option["key"],
+					"value":     value,
+					"sensitive": option["sensitive"] == true,
+				})
+			}
+		}
+
+		identitySource["properties"] = properties
+		return identitySource, nil
+	}
+
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "instanceName",
+		Entitlements:     config.Entitlements{config.EntitlementManageIdentitySources},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageIdentitySources, config.EntitlementReadIdentitySources},
+		APIVersions:      []string{"1.0", "2.0"},
+		DataType:         reflect.TypeOf(authentication.SignInOptions{}),
+		Required:         []string{"instanceName"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			return findIdentitySource(ctx, kind, id, name)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			merged, err := merge(current, data)
+			if err != nil {
+				return err
+			}
+
+			identitySource := &authentication.IdentitySource{}
+			if err := decodeData(ctx, merged, identitySource); err != nil {
+				return err
+			}
+
+			return NewIdentitySourceClient(ctx).UpdateSignInOptions(ctx, identitySource)
+		},
+		desired: merge,
+	}
+}
+
+func apiClientHandler() *KindHandler {
+	kind := ResourceTypePrefix + "APIClient"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "clientName",
+		Entitlements:     config.Entitlements{config.EntitlementManageAPIClients},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageAPIClients, config.EntitlementReadAPIClients},
+		APIVersions:      []string{"1.0"},
+		DataType:         reflect.TypeOf(security.APIClientConfig{}),
+		Required:         []string{"clientName"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			client := NewAPIClient(ctx)
+			if len(id) > 0 {
+				found, err := exists(ctx, "/v1.0/apiclients/"+url.PathEscape(id), "application/json")
+				if err != nil {
+					return nil, err
+				}
+
+				if found {
+					apiClient, uri, err := client.GetAPIClientByID(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+
+					return newObject(kind, id, apiClient.ClientName, uri, apiClient), nil
+				}
+			}
+
+			// the search matches the API clients with the name as a sub-string
+			var found *ResourceObject
+			search := fmt.Sprintf(`clientName contains "%s"`, filterValue(name))
+			err := readPages(func(page int, limit int) (int, int, error) {
+				apiClients, uri, err := client.GetAPIClients(ctx, search, "", page, limit)
+				if err != nil || apiClients.APIClients == nil {
+					return 0, 0, err
+				}
+
+				for _, apiClient := range *apiClients.APIClients {
+					if apiClient.ClientName == name {
+						found = newObject(kind, stringValue(apiClient.ID), apiClient.ClientName, listURI(uri), &apiClient)
+						return 0, 0, nil
+					}
+				}
+
+				return len(*apiClients.APIClients), int(int32Value(apiClients.Total)), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return found, nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			apiClient := &security.APIClientConfig{}
+			if err := decodeData(ctx, data, apiClient); err != nil {
+				return "", err
+			}
+
+			return NewAPIClient(ctx).CreateAPIClient(ctx, apiClient)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			apiClient := &security.APIClientConfig{}
+			if err := decodeData(ctx, data, apiClient); err != nil {
+				return err
+			}
+
+			apiClient.ID = &current.Metadata.UID
+			return NewAPIClient(ctx).UpdateAPIClient(ctx, apiClient)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			client := NewAPIClient(ctx)
+			objects := []*ResourceObject{}
+			err := readPages(func(page int, limit int) (int, int, error) {
+				apiClients, uri, err := client.GetAPIClients(ctx, "", "", page, limit)
+				if err != nil || apiClients.APIClients == nil {
+					return 0, 0, err
+				}
+
+				for _, apiClient := range *apiClients.APIClients {
+					objects = append(objects, newObject(kind, stringValue(apiClient.ID), apiClient.ClientName, listURI(uri), &apiClient))
+				}
+
+				return len(*apiClients.APIClients), int(int32Value(apiClients.Total)), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewAPIClient(ctx).DeleteAPIClientById(ctx, current.Metadata.UID)
+		},
+	}
+}
+
+func applicationHandler() *KindHandler {
+	kind := ResourceTypePrefix + "Application"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "name",
+		Entitlements:     config.Entitlements{config.EntitlementManageAppAccessAdmin},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageAppAccessAdmin},
+		APIVersions:      []string{"1.0"},
+		DataType:         reflect.TypeOf(applications.Application{}),
+		Required:         []string{"name", "templateId"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			client := NewApplicationClient(ctx)
+			if len(id) > 0 {
+				found, err := exists(ctx, "/v1.0/applications/"+url.PathEscape(id), "application/json")
+				if err != nil {
+					return nil, err
+				}
+
+				if found {
+					application, uri, err := client.GetApplicationByID(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+
+					return newObject(kind, id, application.Name, uri, application), nil
+				}
+			}
+
+			// the search matches the applications with the name as a sub-string
+			found := ""
+			err := readPages(func(page int, limit int) (int, int, error) {
+				list, _, err := client.GetApplications(ctx, "q="+name, "", page, limit)
+				if err != nil || list.Embedded == nil || list.Embedded.Applications == nil {
+					return 0, 0, err
+				}
+
+				for _, application := range *list.Embedded.Applications {
+					if application.Name == name && application.Links != nil && application.Links.Self != nil {
+						found = path.Base(application.Links.Self.Href)
+						return 0, 0, nil
+					}
+				}
+
+				return len(*list.Embedded.Applications), int(int32Value(list.TotalCount)), nil
+			})
+			if err != nil || len(found) == 0 {
+				return nil, err
+			}
+
+			current, uri, err := client.GetApplicationByID(ctx, found)
+			if err != nil {
+				return nil, err
+			}
+
+			return newObject(kind, found, current.Name, uri, current), nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			application := &applications.Application{}
+			if err := decodeData(ctx, data, application); err != nil {
+				return "", err
+			}
+
+			return NewApplicationClient(ctx).CreateApplication(ctx, application)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			application := &applications.Application{}
+			if err := decodeData(ctx, data, application); err != nil {
+				return err
+			}
+
+			return NewApplicationClient(ctx).UpdateApplication(ctx, current.Metadata.UID, application)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			client := NewApplicationClient(ctx)
+			ids := []string{}
+			err := readPages(func(page int, limit int) (int, int, error) {
+				list, _, err := client.GetApplications(ctx, "", "", page, limit)
+				if err != nil || list.Embedded == nil || list.Embedded.Applications == nil {
+					return 0, 0, err
+				}
+
+				for _, a := range *list.Embedded.Applications {
+					if a.Links != nil && a.Links.Self != nil {
+						ids = append(ids, path.Base(a.Links.Self.Href))
+					}
+				}
+
+				return len(*list.Embedded.Applications), int(int32Value(list.TotalCount)), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// the list only holds a summary of the applications
+			objects := []*ResourceObject{}
+			for _, id := range ids {
+				application, uri, err := client.GetApplicationByID(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+
+				objects = append(objects, newObject(kind, id, application.Name, uri, application))
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewApplicationClient(ctx).DeleteApplicationByID(ctx, current.Metadata.UID)
+		},
+	}
+}
+
+func identityAgentHandler() *KindHandler {
+	kind := ResourceTypePrefix + "IdentityAgent"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "name",
+		Entitlements:     config.Entitlements{config.EntitlementManageExternalAgents},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageExternalAgents, config.EntitlementReadExternalAgents},
+		APIVersions:      []string{"1.0"},
+		DataType:         reflect.TypeOf(integrations.IdentityAgentConfig{}),
+		Required:         []string{"name"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			client := NewIdentityAgentClient(ctx)
+			if len(id) > 0 {
+				found, err := exists(ctx, "/config/v1.0/onpremagents/"+url.PathEscape(id), "application/json")
+				if err != nil {
+					return nil, err
+				}
+
+				if found {
+					agent, uri, err := client.GetIdentityAgentByID(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+
+					return newObject(kind, id, agent.Name, uri, agent), nil
+				}
+			}
+
+			var found *ResourceObject
+			search := fmt.Sprintf(`name = "%s"`, filterValue(name))
+			err := readPages(func(page int, limit int) (int, int, error) {
+				agents, uri, err := client.GetIdentityAgents(ctx, search, page, limit)
+				if err != nil {
+					return 0, 0, err
+				}
+
+				for _, agent := range *agents {
+					if agent.Name == name {
+						found = newObject(kind, stringValue(agent.ID), agent.Name, listURI(uri), &agent)
+						return 0, 0, nil
+					}
+				}
+
+				return len(*agents), 0, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return found, nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			agent := &integrations.IdentityAgentConfig{}
+			if err := decodeData(ctx, data, agent); err != nil {
+				return "", err
+			}
+
+			return NewIdentityAgentClient(ctx).CreateIdentityAgent(ctx, agent)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			agent := &integrations.IdentityAgentConfig{}
+			if err := decodeData(ctx, data, agent); err != nil {
+				return err
+			}
+
+			agent.ID = &current.Metadata.UID
+			return NewIdentityAgentClient(ctx).UpdateIdentityAgent(ctx, agent)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			client := NewIdentityAgentClient(ctx)
+			objects := []*ResourceObject{}
+			err := readPages(func(page int, limit int) (int, int, error) {
+				agents, uri, err := client.GetIdentityAgents(ctx, "", page, limit)
+				if err != nil {
+					return 0, 0, err
+				}
+
+				// the API does not report the total
+				for _, agent := range *agents {
+					objects = append(objects, newObject(kind, stringValue(agent.ID), agent.Name, listURI(uri), &agent))
+				}
+
+				return len(*agents), 0, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewIdentityAgentClient(ctx).DeleteIdentityAgentByID(ctx, current.Metadata.UID)
+		},
+	}
+}
+
+func passwordPolicyHandler() *KindHandler {
+	kind := ResourceTypePrefix + "PasswordPolicy"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "policyName",
+		Entitlements:     config.Entitlements{config.EntitlementManagePwdPolicy},
+		ReadEntitlements: config.Entitlements{config.EntitlementManagePwdPolicy, config.EntitlementReadPwdPolicy},
+		APIVersions:      []string{"1.0", "3.0"},
+		DataType:         reflect.TypeOf(security.PasswordPolicy{}),
+		Required:         []string{"policyName"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			client := NewPasswordPolicyClient(ctx)
+			if len(id) > 0 {
+				found, err := exists(ctx, "/v3.0/PasswordPolicies/"+url.PathEscape(id), "application/scim+json")
+				if err != nil {
+					return nil, err
+				}
+
+				if found {
+					policy, uri, err := client.GetPasswordPolicyByID(ctx, id)
+					if err != nil {
+						return nil, err
+					}
+
+					return newObject(kind, id, policy.PolicyName, uri, policy), nil
+				}
+			}
+
+			// the API does not filter the policies by name
+			resources, uri, err := listSCIM(ctx, "/v3.0/PasswordPolicies", "")
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resource := range resources {
+				policy := &security.PasswordPolicy{}
+				if err := json.Unmarshal(resource, policy); err != nil {
+					return nil, err
+				}
+
+				if policy.PolicyName == name {
+					return newObject(kind, policy.ID, policy.PolicyName, uri, policy), nil
+				}
+			}
+
+			return nil, nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			policy := &security.PasswordPolicy{}
+			if err := decodeData(ctx, data, policy); err != nil {
+				return "", err
+			}
+
+			return NewPasswordPolicyClient(ctx).CreatePasswordPolicy(ctx, policy)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			policy := &security.PasswordPolicy{}
+			if err := decodeData(ctx, data, policy); err != nil {
+				return err
+			}
+
+			policy.ID = current.Metadata.UID
+			return NewPasswordPolicyClient(ctx).UpdatePasswordPolicy(ctx, policy)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			// the SDK does not read the policies page by page
+			resources, uri, err := listSCIM(ctx, "/v3.0/PasswordPolicies", "")
+			if err != nil {
+				return nil, err
+			}
+
+			objects := []*ResourceObject{}
+			for _, resource := range resources {
+				policy := &security.PasswordPolicy{}
+				if err := json.Unmarshal(resource, policy); err != nil {
+					return nil, err
+				}
+
+				objects = append(objects, newObject(kind, policy.ID, policy.PolicyName, uri, policy))
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewPasswordPolicyClient(ctx).DeletePasswordPolicyByID(ctx, current.Metadata.UID)
+		},
+	}
+}
+
+func personalCertHandler() *KindHandler {
+	kind := ResourceTypePrefix + "PersonalCert"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "label",
+		Entitlements:     config.Entitlements{config.EntitlementManageCerts},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageCerts, config.EntitlementReadCerts},
+		APIVersions:      []string{"1.0"},
+		DataType:         reflect.TypeOf(security.PersonalCert{}),
+		Required:         []string{"label"},
+		find: func(ctx context.Context, _ string, name string) (*ResourceObject, error) {
+			certs, uri, err := NewPersonalCertClient(ctx).GetPersonalCerts(ctx, "", "")
+			if err != nil {
+				return nil, err
+			}
+
+			for _, cert := range certs.PersonalCerts {
+				if cert.Label == name {
+					return newObject(kind, cert.Label, cert.Label, uri, &cert), nil
+				}
+			}
+
+			return nil, nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			cert := &security.PersonalCert{}
+			if err := decodeData(ctx, data, cert); err != nil {
+				return "", err
+			}
+
+			return NewPersonalCertClient(ctx).CreatePersonalCert(ctx, cert)
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			cert := &security.PersonalCert{}
+			if err := decodeData(ctx, data, cert); err != nil {
+				return err
+			}
+
+			cert.Label = current.Metadata.Name
+			return NewPersonalCertClient(ctx).UpdatePersonalCert(ctx, cert)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			// the API returns all the certificates at once
+			client := NewPersonalCertClient(ctx)
+			certs, _, err := client.GetPersonalCerts(ctx, "", "")
+			if err != nil {
+				return nil, err
+			}
+
+			// the list does not hold the certificates
+			objects := []*ResourceObject{}
+			for _, c := range certs.PersonalCerts {
+				cert, uri, err := client.GetPersonalCert(ctx, c.Label)
+				if err != nil {
+					return nil, err
+				}
+
+				objects = append(objects, newObject(kind, cert.Label, cert.Label, uri, cert))
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewPersonalCertClient(ctx).DeletePersonalCert(ctx, current.Metadata.Name)
+		},
+	}
+}
+
+// signerCertHandler creates and deletes signer certificates. They cannot be updated.
+func signerCertHandler() *KindHandler {
+	kind := ResourceTypePrefix + "SignerCert"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "label",
+		Entitlements:     config.Entitlements{config.EntitlementManageCerts},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageCerts, config.EntitlementReadCerts},
+		APIVersions:      []string{"1.0"},
+		DataType:         reflect.TypeOf(security.SignerCert{}),
+		Required:         []string{"label", "cert"},
+		find: func(ctx context.Context, _ string, name string) (*ResourceObject, error) {
+			certs, uri, err := NewSignerCertClient(ctx).GetSignerCerts(ctx, "", "")
+			if err != nil {
+				return nil, err
+			}
+
+			for _, cert := range certs.SignerCerts {
+				if cert.Label == name {
+					return newObject(kind, cert.Label, cert.Label, uri, &cert), nil
+				}
+			}
+
+			return nil, nil
+		},
+		create: func(ctx context.Context, data map[string]interface{}) (string, error) {
+			cert := &security.SignerCert{}
+			if err := decodeData(ctx, data, cert); err != nil {
+				return "", err
+			}
+
+			return NewSignerCertClient(ctx).CreateSignerCert(ctx, cert)
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			// the API returns all the certificates at once
+			client := NewSignerCertClient(ctx)
+			certs, _, err := client.GetSignerCerts(ctx, "", "")
+			if err != nil {
+				return nil, err
+			}
+
+			// the list does not hold the certificates
+			objects := []*ResourceObject{}
+			for _, c := range certs.SignerCerts {
+				cert, uri, err := client.GetSignerCert(ctx, c.Label)
+				if err != nil {
+					return nil, err
+				}
+
+				objects = append(objects, newObject(kind, cert.Label, cert.Label, uri, cert))
+			}
+
+			return objects, nil
+		},
+		remove: func(ctx context.Context, current *ResourceObject) error {
+			return NewSignerCertClient(ctx).DeleteSignerCert(ctx, current.Metadata.Name)
+		},
+	}
+}
+
+// themeData is the data of a theme, which has no type in the SDK.
+type themeData struct {
+	// Name is the name of the theme.
+	Name string `json:"name"`
+
+	// Description describes the theme.
+	Description string `json:"description,omitempty"`
+
+	// Files is the base64-encoded zip file with the customized templates.
+	Files string `json:"files"`
+}
+
+// themeObject returns the theme with the customized templates as a base64-encoded
+// zip file in the 'files' property.
+func themeObject(ctx context.Context, kind string, theme *branding.Theme) (*ResourceObject, error) {
+	b, uri, err := NewThemeClient(ctx).GetTheme(ctx, theme.ThemeID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return newObject(kind, theme.ThemeID, theme.Name, uri, map[string]interface{}{
+		"name":        theme.Name,
+		"description": theme.Description,
+		"files":       base64.StdEncoding.EncodeToString(b),
+	}), nil
+}
+
+// listThemes returns all the themes on the tenant.
+func listThemes(ctx context.Context) ([]*branding.Theme, error) {
+	client := NewThemeClient(ctx)
+	themes := []*branding.Theme{}
+	err := readPages(func(page int, limit int) (int, int, error) {
+		list, _, err := client.ListThemes(ctx, 0, page, limit)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		themes = append(themes, list.Themes...)
+		return len(list.Themes), list.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return themes, nil
+}
+
+// themeHandler updates themes. Themes are created and deleted using the admin
+// console, because the SDK does not support it.
+func themeHandler() *KindHandler {
+	kind := ResourceTypePrefix + "Theme"
+	return &KindHandler{
+		Kind:             kind,
+		NameField:        "name",
+		Entitlements:     config.Entitlements{config.EntitlementManageTemplates},
+		ReadEntitlements: config.Entitlements{config.EntitlementManageTemplates, config.EntitlementReadTemplates},
+		APIVersions:      []string{"1.0"},
+		DataType:         reflect.TypeOf(themeData{}),
+		Required:         []string{"name", "files"},
+		find: func(ctx context.Context, id string, name string) (*ResourceObject, error) {
+			themes, err := listThemes(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, theme := range themes {
+				if (len(id) > 0 && theme.ThemeID == id) || (len(id) == 0 && theme.Name == name) {
+					return themeObject(ctx, kind, theme)
+				}
+			}
+
+			// fall back to the name if the ID is from another tenant
+			for _, theme := range themes {
+				if len(id) > 0 && theme.Name == name {
+					return themeObject(ctx, kind, theme)
+				}
+			}
+
+			return nil, nil
+		},
+		list: func(ctx context.Context) ([]*ResourceObject, error) {
+			themes, err := listThemes(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			objects := []*ResourceObject{}
+			for _, theme := range themes {
+				obj, err := themeObject(ctx, kind, theme)
+				if err != nil {
+					return nil, err
+				}
+
+				objects = append(objects, obj)
+			}
+
+			return objects, nil
+		},
+		update: func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error {
+			files, _ := data["files"].(string)
+			b, err := base64.StdEncoding.DecodeString(files)
+			if err == nil && len(b) == 0 {
+				err = errorsx.G11NError("no files")
+			}
+
+			if err != nil {
+				return errorsx.G11NError("The 'files' of the theme must be a base64-encoded zip file; err=%v", err)
+			}
+
+			metadata := map[string]any{}
+			for _, key := range []string{"name", "description"} {
+				if v, ok := data[key]; ok {
+					metadata[key] = v
+				}
+			}
+
+			return NewThemeClient(ctx).UpdateTheme(ctx, current.Metadata.UID, b, metadata)
+		},
+	}
+}
+
+func newObject(kind string, id string, name string, uri string, data interface{}) *ResourceObject {
+	return &ResourceObject{
+		Kind:       kind,
+		APIVersion: "1.0",
+		Metadata: &ResourceObjectMetadata{
+			UID:  id,
+			Name: name,
+			URI:  uri,
+		},
+		Data: data,
+	}
+}
+
+// decodeData converts the data read from the file to the SDK type.
+func decodeData(ctx context.Context, data map[string]interface{}, v interface{}) error {
+	vc := contextx.GetVerifyContext(ctx)
+	b, err := json.Marshal(data)
+	if err != nil {
+		vc.Logger.Errorf("failed to marshal the data map; err=%v", err)
+		return err
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		vc.Logger.Errorf("unable to unmarshal the data; err=%v", err)
+		return err
+	}
+
+	return nil
+}
+
+// patchOperations returns the SCIM operations that replace the properties of the
+// user or group that differ from the data. The keys are not changed.
+func patchOperations(current *ResourceObject, data map[string]interface{}, keys ...string) ([]directory.UserPatchOperation, error) {
+	desired, err := toGeneric(data)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := toGeneric(current.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := v.(map[string]interface{})
+	operations := []directory.UserPatchOperation{}
+	for key, value := range desired.(map[string]interface{}) {
+		if slices.Contains(keys, key) || equal(value, actual[key]) {
+			continue
+		}
+
+		if _, found := actual[key]; !found && secret.IsSecretKey(key) {
+			continue
+		}
+
+		value := value
+		operations = append(operations, directory.UserPatchOperation{
+			Op:    "replace",
+			Path:  key,
+			Value: &value,
+		})
+	}
+
+	// keep the order stable
+	slices.SortFunc(operations, func(a, b directory.UserPatchOperation) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return operations, nil
+}
+
+// updateOperations returns the SCIM operations in the data if it is a patch request,
+// which is the format read by 'replace user' and 'replace group'. Otherwise, they
+// replace the properties of the user or group that differ from the data.
+func updateOperations(ctx context.Context, current *ResourceObject, data map[string]interface{}, keys ...string) ([]directory.UserPatchOperation, error) {
+	if _, ok := data["scimPatch"]; !ok {
+		return patchOperations(current, data, keys...)
+	}
+
+	request := &directory.UserPatchRequest{}
+	if err := decodeData(ctx, data, request); err != nil {
+		return nil, err
+	}
+
+	if request.SCIMPatchRequest == nil {
+		return nil, nil
+	}
+
+	return request.SCIMPatchRequest.Operations, nil
+}
+
+// findSCIM returns the SCIM resources of the type, such as 'Users', with the value
+// of the attribute, and the URI they are read from.
+func findSCIM(ctx context.Context, resourceType string, attribute string, value string) ([]json.RawMessage, string, error) {
+	return listSCIM(ctx, "/v2.0/"+resourceType, fmt.Sprintf(`%s eq "%s"`, attribute, filterValue(value)))
+}
+
+// listSCIM returns the SCIM resources read from the path, such as '/v2.0/Users',
+// that match the filter, if any, and the URI they are read from. The status code
+// is checked, so that a resource that does not exist is not mistaken for an error.
+func listSCIM(ctx context.Context, resourcePath string, filter string) ([]json.RawMessage, string, error) {
+	vc := contextx.GetVerifyContext(ctx)
+	u, _ := url.Parse(fmt.Sprintf("https://%s%s", vc.Tenant, resourcePath))
+	uri := u.String()
+	headers := http.Header{
+		"Accept":        []string{"application/scim+json"},
+		"Authorization": []string{"Bearer " + vc.Token},
+	}
+
+	resources := []json.RawMessage{}
+	err := readPages(func(page int, limit int) (int, int, error) {
+		query := url.Values{
+			"startIndex": []string{strconv.Itoa((page-1)*limit + 1)},
+			"count":      []string{strconv.Itoa(limit)},
+		}
+		if len(filter) > 0 {
+			query.Set("filter", filter)
+		}
+
+		u.RawQuery = query.Encode()
+		response, err := xhttp.NewDefaultClient().Get(ctx, u, headers)
+		if err != nil {
+			vc.Logger.Errorf("unable to read the resources; path=%s, err=%v", resourcePath, err)
+			return 0, 0, err
+		}
+
+		if response.StatusCode == http.StatusNotFound {
+			return 0, 0, nil
+		}
+
+		if response.StatusCode != http.StatusOK {
+			if err := module.HandleCommonErrorsX(ctx, response, "unable to read the resources"); err != nil {
+				vc.Logger.Errorf("unable to read the resources; path=%s, err=%v", resourcePath, err)
+				return 0, 0, err
+			}
+
+			return 0, 0, errorsx.G11NError("unable to read the resources; code=%d", response.StatusCode)
+		}
+
+		list := &struct {
+			TotalResults int               `json:"totalResults"`
+			Resources    []json.RawMessage `json:"Resources"`
+		}{}
+		if err := json.Unmarshal(response.Body, list); err != nil {
+			vc.Logger.Errorf("unable to parse the resources; path=%s, err=%v", resourcePath, err)
+			return 0, 0, err
+		}
+
+		resources = append(resources, list.Resources...)
+		return len(list.Resources), list.TotalResults, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return resources, uri, nil
+}
+
+// exists reports whether the resource read from the path, such as '/v1.0/attributes/1',
+// is on the tenant. Only a resource that is not found is reported as missing, so that
+// the lookups by ID do not fall back to the name when the tenant returns an error.
+func exists(ctx context.Context, resourcePath string, accept string) (bool, error) {
+	vc := contextx.GetVerifyContext(ctx)
+	u, _ := url.Parse(fmt.Sprintf("https://%s%s", vc.Tenant, resourcePath))
+	headers := http.Header{
+		"Accept":        []string{accept},
+		"Authorization": []string{"Bearer " + vc.Token},
+	}
+
+	response, err := xhttp.NewDefaultClient().Get(ctx, u, headers)
+	if err != nil {
+		vc.Logger.Errorf("unable to look up the resource; path=%s, err=%v", resourcePath, err)
+		return false, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+
+	if err := module.HandleCommonErrorsX(ctx, response, "unable to look up the resource"); err != nil {
+		vc.Logger.Errorf("unable to look up the resource; path=%s, err=%v", resourcePath, err)
+		return false, err
+	}
+
+	return false, errorsx.G11NError("unable to look up the resource; code=%d", response.StatusCode)
+}
+
+// pageSize is the number of resources read in each request when they are listed.
+const pageSize = 100
+
+// readPages calls read with the pages, starting at 1, until a page holds fewer
+// resources than the page size or the total number of resources has been read.
+// read returns the number of resources in the page and the total reported by the
+// API, or 0 if it is not reported. It returns a count of 0 to stop reading, such
+// as when it has found the resource it looks for.
+func readPages(read func(page int, limit int) (int, int, error)) error {
+	seen := 0
+	for page := 1; ; page++ {
+		count, total, err := read(page, pageSize)
+		if err != nil {
+			return err
+		}
+
+		seen += count
+		if count < pageSize || (total > 0 && seen >= total) {
+			return nil
+		}
+	}
+}
+
+// listURI returns the URI of the list without the query, which holds the page.
+func listURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+
+	u.RawQuery = ""
+	return u.String()
+}
+
+// filterValue escapes the quotes and backslashes in a value used in a quoted string
+// of a search filter.
+func filterValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func int32Value(i *int32) int32 {
+	if i == nil {
+		return 0
+	}
+
+	return *i
+}
@@ -0,0 +1,528 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	"github.com/ibm-verify/verifyctl/pkg/util/schema"
+	"github.com/ibm-verify/verifyctl/pkg/util/secret"
+	"gopkg.in/yaml.v3"
+)
+
+// Results of applying a resource
+const (
+	ApplyCreated   = "created"
+	ApplyUpdated   = "updated"
+	ApplyUnchanged = "unchanged"
+)
+
+// kindAliases maps the kinds generated by older releases to the current ones.
+var kindAliases = map[string]string{
+	ResourceTypePrefix + "Applications": ResourceTypePrefix + "Application",
+	ResourceTypePrefix + "ApiClient":    ResourceTypePrefix + "APIClient",
+}
+
+// KindHandler manages the resources of a kind on the tenant.
+type KindHandler struct {
+	// Kind is the kind of the resource, such as 'IBMVerifyApplication'.
+	Kind string
+
+	// NameField is the property in the data that holds the name of the resource.
+	NameField string
+
+	// Entitlements grant access to create, update and delete the resources.
+	Entitlements config.Entitlements
+
+	// ReadEntitlements grant access to read the resources.
+	ReadEntitlements config.Entitlements
+
+	// APIVersions are the versions accepted in resource files.
+	APIVersions []string
+
+	// DataType is the type the data is decoded into. It describes the properties of
+	// the resource.
+	DataType reflect.Type
+
+	// Required are the properties that must be in the data.
+	Required []string
+
+	// patchType is the type of the data if it is a SCIM patch request, which is the
+	// format read by 'replace user' and 'replace group'. It is nil if the data cannot
+	// be a patch request.
+	patchType reflect.Type
+
+	// find returns the resource with the ID or, if there is none, the name. It returns
+	// nil if neither exist.
+	find func(ctx context.Context, id string, name string) (*ResourceObject, error)
+
+	// list returns all the resources of the kind on the tenant. It is nil if the
+	// resources cannot be listed.
+	list func(ctx context.Context) ([]*ResourceObject, error)
+
+	// create creates the resource and returns its URI. It is nil if the resources
+	// cannot be created.
+	create func(ctx context.Context, data map[string]interface{}) (string, error)
+
+	// update replaces the resource found on the tenant. It is nil if the resources
+	// cannot be updated.
+	update func(ctx context.Context, current *ResourceObject, data map[string]interface{}) error
+
+	// remove deletes the resource found on the tenant. It is nil if the resources
+	// cannot be deleted.
+	remove func(ctx context.Context, current *ResourceObject) error
+
+	// desired returns the resource as it is expected to be on the tenant after it is
+	// updated with the data. If it is nil, only the properties in the data are compared.
+	desired func(current *ResourceObject, data map[string]interface{}) (map[string]interface{}, error)
+
+	// normalize returns the data in the form that is compared with the resource on
+	// the tenant and passed to create and update, such as the members of a group
+	// identified by userName. It is nil if the data is used as is.
+	normalize func(data map[string]interface{}) map[string]interface{}
+}
+
+// serverManagedKeys are the properties set by the tenant, such as IDs, timestamps and
+// URIs. They are ignored when resources are compared.
+var serverManagedKeys = []string{
+	"id", "_links", "meta", "uri",
+	"created", "createdAt", "createdBy",
+	"modified", "modifiedBy", "lastModified",
+	"updatedAt", "updatedBy",
+}
+
+// NormalizeKind returns the current name of the kind.
+func NormalizeKind(kind string) string {
+	if k, ok := kindAliases[kind]; ok {
+		return k
+	}
+
+	return kind
+}
+
+// GetKindHandler returns the handler for the kind of the resource.
+func GetKindHandler(kind string) (*KindHandler, error) {
+	if h, ok := kindHandlers[NormalizeKind(kind)]; ok {
+		return h, nil
+	}
+
+	if len(kind) == 0 {
+		return nil, errorsx.G11NError("No 'kind' defined. Resource type cannot be identified.")
+	}
+
+	return nil, errorsx.G11NError("The kind '%s' is not supported.", kind)
+}
+
+// KindHandlers returns the handlers of all the kinds sorted by kind.
+func KindHandlers() []*KindHandler {
+	handlers := make([]*KindHandler, 0, len(kindHandlers))
+	for _, h := range kindHandlers {
+		handlers = append(handlers, h)
+	}
+
+	slices.SortFunc(handlers, func(a, b *KindHandler) int {
+		return strings.Compare(a.Kind, b.Kind)
+	})
+
+	return handlers
+}
+
+// Name returns the name of the resource from the metadata or the data.
+func (h *KindHandler) Name(obj *ResourceObject) string {
+	if obj.Metadata != nil && len(obj.Metadata.Name) > 0 {
+		return obj.Metadata.Name
+	}
+
+	if data, ok := obj.Data.(map[string]interface{}); ok {
+		name, _ := data[h.NameField].(string)
+		return name
+	}
+
+	return ""
+}
+
+// Schema returns the schema of the data.
+func (h *KindHandler) Schema() *schema.Schema {
+	s := schema.FromType(h.DataType, typeDocs)
+	s.Required = h.Required
+	return s
+}
+
+// dataSchema returns the schema of the data, which is the schema of the patch
+// requests if the data holds one.
+func (h *KindHandler) dataSchema(isPatch bool) *schema.Schema {
+	if isPatch && h.patchType != nil {
+		return schema.FromType(h.patchType, typeDocs)
+	}
+
+	return h.Schema()
+}
+
+// Validate checks the data of the resource against the schema of the kind and
+// decodes it in the same way as when the resource is created or updated, so that
+// a resource can be checked without changing the tenant.
+func (h *KindHandler) Validate(ctx context.Context, obj *ResourceObject) error {
+	data, err := dataMap(obj)
+	if err != nil {
+		return err
+	}
+
+	_, isPatch := data["scimPatch"]
+	node := &yaml.Node{}
+	if err := node.Encode(data); err != nil {
+		return err
+	}
+
+	errs := schema.Validate(node, h.dataSchema(isPatch), "data")
+	if len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, e := range errs {
+			messages = append(messages, e.Path+": "+e.Message)
+		}
+
+		return errorsx.G11NError("The '%s' resource '%s' is not valid:\n  %s", h.Kind, h.Name(obj), strings.Join(messages, "\n  "))
+	}
+
+	if isPatch && h.patchType != nil {
+		return decodeData(ctx, data, reflect.New(h.patchType).Interface())
+	}
+
+	return decodeData(ctx, data, reflect.New(h.DataType).Interface())
+}
+
+// Find returns the resource on the tenant identified by the UID in the metadata or
+// by the name. It returns nil if the resource does not exist.
+func (h *KindHandler) Find(ctx context.Context, obj *ResourceObject) (*ResourceObject, error) {
+	id := ""
+	if obj.Metadata != nil {
+		id = obj.Metadata.UID
+	}
+
+	name := h.Name(obj)
+	if len(id) == 0 && len(name) == 0 {
+		return nil, errorsx.G11NError("The '%s' resource has no UID or '%s'. It cannot be identified on the tenant.", h.Kind, h.NameField)
+	}
+
+	return h.find(ctx, id, name)
+}
+
+// Export returns the resources of the kind on the tenant in the form used in resource
+// files. Properties managed by the tenant are removed from the data, so that the
+// resources can be created on another tenant.
+func (h *KindHandler) Export(ctx context.Context) ([]*ResourceObject, error) {
+	if h.list == nil {
+		return nil, errorsx.G11NError("The '%s' resources cannot be listed.", h.Kind)
+	}
+
+	objects, err := h.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objects {
+		data, err := toGeneric(obj.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		obj.Data = withoutServerManaged(data)
+		obj.Metadata.URI = ""
+	}
+
+	slices.SortStableFunc(objects, func(a, b *ResourceObject) int {
+		return strings.Compare(a.Metadata.Name, b.Metadata.Name)
+	})
+
+	return objects, nil
+}
+
+// Apply creates the resource if it does not exist on the tenant, or updates it if
+// it differs. It returns ApplyCreated, ApplyUpdated or ApplyUnchanged.
+func (h *KindHandler) Apply(ctx context.Context, obj *ResourceObject) (string, error) {
+	vc := contextx.GetVerifyContext(ctx)
+	current, err := h.Find(ctx, obj)
+	if err != nil {
+		vc.Logger.Errorf("unable to look up the resource; kind=%s, err=%v", h.Kind, err)
+		return "", err
+	}
+
+	if current == nil {
+		if _, err := h.Create(ctx, obj); err != nil {
+			vc.Logger.Errorf("unable to create the resource; kind=%s, err=%v", h.Kind, err)
+			return "", err
+		}
+
+		return ApplyCreated, nil
+	}
+
+	changed, err := h.Changed(current, obj)
+	if err != nil {
+		return "", err
+	}
+
+	if !changed {
+		return ApplyUnchanged, nil
+	}
+
+	if err := h.Update(ctx, current, obj); err != nil {
+		vc.Logger.Errorf("unable to update the resource; kind=%s, id=%s, err=%v", h.Kind, current.Metadata.UID, err)
+		return "", err
+	}
+
+	return ApplyUpdated, nil
+}
+
+// Creatable reports whether resources of the kind can be created.
+func (h *KindHandler) Creatable() bool {
+	return h.create != nil
+}
+
+// Updatable reports whether resources of the kind can be updated.
+func (h *KindHandler) Updatable() bool {
+	return h.update != nil
+}
+
+// Create creates the resource and returns its URI.
+func (h *KindHandler) Create(ctx context.Context, obj *ResourceObject) (string, error) {
+	if h.create == nil {
+		return "", errorsx.G11NError("The '%s' resource '%s' does not exist and resources of this kind cannot be created.", h.Kind, h.Name(obj))
+	}
+
+	data, err := h.data(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return h.create(ctx, data)
+}
+
+// Update replaces the resource on the tenant.
+func (h *KindHandler) Update(ctx context.Context, current *ResourceObject, obj *ResourceObject) error {
+	if h.update == nil {
+		return errorsx.G11NError("The '%s' resource '%s' cannot be updated. Delete it and create it again.", h.Kind, h.Name(obj))
+	}
+
+	data, err := h.data(obj)
+	if err != nil {
+		return err
+	}
+
+	return h.update(ctx, current, data)
+}
+
+// Delete deletes the resource found on the tenant.
+func (h *KindHandler) Delete(ctx context.Context, current *ResourceObject) error {
+	if h.remove == nil {
+		return errorsx.G11NError("The '%s' resource '%s' cannot be deleted.", h.Kind, current.Metadata.Name)
+	}
+
+	return h.remove(ctx, current)
+}
+
+// Changed reports whether the resource differs from the one on the tenant. Only
+// the properties in the resource are compared, so properties managed by the tenant,
+// such as IDs and timestamps, are ignored. Secrets that the tenant does not return
+// are ignored as well.
+func (h *KindHandler) Changed(current *ResourceObject, obj *ResourceObject) (bool, error) {
+	desired, actual, err := h.Compare(current, obj)
+	if err != nil {
+		return false, err
+	}
+
+	return !reflect.DeepEqual(desired, actual), nil
+}
+
+// Compare returns the resource and the one on the tenant in a normalized form, so
+// that they are equal if applying the resource does not change anything. Properties
+// managed by the tenant are removed and the resource on the tenant is limited to the
+// properties in the resource. If current is nil, actual is nil as well.
+func (h *KindHandler) Compare(current *ResourceObject, obj *ResourceObject) (desired interface{}, actual interface{}, err error) {
+	data, err := h.data(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if current == nil {
+		desired, err = toGeneric(data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return withoutServerManaged(desired), nil, nil
+	}
+
+	if actual, err = toGeneric(current.Data); err != nil {
+		return nil, nil, err
+	}
+
+	if h.desired != nil {
+		merged, err := h.desired(current, data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if desired, err = toGeneric(merged); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		if desired, err = toGeneric(data); err != nil {
+			return nil, nil, err
+		}
+
+		desired, actual = prune(desired, actual)
+	}
+
+	return withoutServerManaged(desired), withoutServerManaged(actual), nil
+}
+
+// data returns the data of the resource, normalized if the kind requires it.
+func (h *KindHandler) data(obj *ResourceObject) (map[string]interface{}, error) {
+	data, err := dataMap(obj)
+	if err != nil || h.normalize == nil {
+		return data, err
+	}
+
+	return h.normalize(data), nil
+}
+
+func dataMap(obj *ResourceObject) (map[string]interface{}, error) {
+	data, ok := obj.Data.(map[string]interface{})
+	if !ok {
+		return nil, errorsx.G11NError("The 'data' of the '%s' resource is missing or invalid.", obj.Kind)
+	}
+
+	return data, nil
+}
+
+// toGeneric converts the value to the types used to decode JSON, so that values
+// read from YAML and JSON can be compared.
+func toGeneric(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret interface{}
+	if err := json.Unmarshal(b, &ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// prune limits actual to the properties in desired. Empty values and secrets in
+// desired that are not returned by the tenant are removed, and values that are empty
+// in both are made the same.
+func prune(desired interface{}, actual interface{}) (interface{}, interface{}) {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			if len(d) == 0 && isEmpty(actual) {
+				return d, d
+			}
+
+			return d, actual
+		}
+
+		prunedDesired := map[string]interface{}{}
+		prunedActual := map[string]interface{}{}
+		for key, value := range d {
+			actualValue, found := a[key]
+			if !found && (isEmpty(value) || secret.IsSecretKey(key)) {
+				// empty values and secrets are not returned by the tenant
+				continue
+			}
+
+			if !found {
+				prunedDesired[key] = value
+				continue
+			}
+
+			prunedDesired[key], prunedActual[key] = prune(value, actualValue)
+		}
+
+		return prunedDesired, prunedActual
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			if len(d) == 0 && isEmpty(actual) {
+				return d, d
+			}
+
+			return d, actual
+		}
+
+		prunedDesired := make([]interface{}, 0, len(d))
+		prunedActual := make([]interface{}, 0, len(a))
+		for i := range d {
+			if i >= len(a) {
+				prunedDesired = append(prunedDesired, d[i])
+				continue
+			}
+
+			pd, pa := prune(d[i], a[i])
+			prunedDesired = append(prunedDesired, pd)
+			prunedActual = append(prunedActual, pa)
+		}
+
+		// items added on the tenant are kept so that they are reported
+		prunedActual = append(prunedActual, a[min(len(d), len(a)):]...)
+		return prunedDesired, prunedActual
+	}
+
+	if isEmpty(desired) && isEmpty(actual) {
+		return desired, desired
+	}
+
+	return desired, actual
+}
+
+// equal reports whether the properties in desired have the same values in actual.
+func equal(desired interface{}, actual interface{}) bool {
+	d, a := prune(desired, actual)
+	return reflect.DeepEqual(d, a)
+}
+
+// withoutServerManaged returns a copy of the data without the properties managed
+// by the tenant. Only the properties of the resource itself are removed, because
+// nested IDs, such as the access policy of an application, refer to other resources.
+func withoutServerManaged(v interface{}) interface{} {
+	data, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	ret := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		if !slices.Contains(serverManagedKeys, key) {
+			ret[key] = value
+		}
+	}
+
+	return ret
+}
+
+func isEmpty(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return len(t) == 0
+	case bool:
+		return !t
+	case float64:
+		return t == 0
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	}
+
+	return false
+}
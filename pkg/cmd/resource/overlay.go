@@ -0,0 +1,157 @@
+package resource
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+
+	"github.com/ibm-verify/verifyctl/pkg/util/patch"
+	"github.com/ibm-verify/verifyctl/pkg/util/schema"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+// OverlayKind is the kind of an overlay, which changes the resources in other files.
+const OverlayKind = ResourceTypePrefix + "Overlay"
+
+// Overlay is the data of an overlay. It includes the resources in other files, such
+// as a base definition shared by several tenants, and patches them, so that only the
+// differences are kept for each tenant.
+type Overlay struct {
+	// Resources are the files or directories that hold the resources to include.
+	// Relative paths are relative to the directory of the overlay file.
+	Resources []string `json:"resources,omitempty" yaml:"resources,omitempty"`
+
+	// Patches are applied in order to the resources read with the overlay.
+	Patches []*OverlayPatch `json:"patches,omitempty" yaml:"patches,omitempty"`
+}
+
+// OverlayPatch changes the data of a resource using either a JSON merge patch or a
+// JSON patch.
+type OverlayPatch struct {
+	// Target identifies the resource to change.
+	Target *OverlayTarget `json:"target" yaml:"target"`
+
+	// MergePatch is a JSON merge patch, as defined in RFC 7396.
+	MergePatch interface{} `json:"mergePatch,omitempty" yaml:"mergePatch,omitempty"`
+
+	// JSONPatch is a JSON patch, as defined in RFC 6902.
+	JSONPatch []patch.Operation `json:"jsonPatch,omitempty" yaml:"jsonPatch,omitempty"`
+}
+
+// OverlayTarget identifies a resource by its kind and name.
+type OverlayTarget struct {
+	// Kind is the kind of the resource, such as 'IBMVerifyApplication'.
+	Kind string `json:"kind" yaml:"kind"`
+
+	// Name is the name of the resource in the metadata or the data.
+	Name string `json:"name" yaml:"name"`
+}
+
+// OverlaySchema returns the schema of the data of an overlay.
+func OverlaySchema() *schema.Schema {
+	return schema.FromType(reflect.TypeOf(Overlay{}), typeDocs)
+}
+
+func toOverlay(r *ResourceObject) (*Overlay, error) {
+	b, err := json.Marshal(r.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	overlay := &Overlay{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(overlay); err != nil {
+		return nil, errorsx.G11NError("Unable to read the overlay in '%s'; err=%v", r.source, err)
+	}
+
+	return overlay, nil
+}
+
+// include reads the resources the overlay includes.
+func (l *loader) include(r *ResourceObject) ([]*ResourceObject, error) {
+	overlay, err := toOverlay(r)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := "."
+	if r.source != "-" {
+		dir = filepath.Dir(r.source)
+	}
+
+	source, err := filepath.Abs(r.source)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.expanding[source] {
+		return nil, errorsx.G11NError("The overlay in '%s' includes itself.", r.source)
+	}
+
+	l.expanding[source] = true
+	defer delete(l.expanding, source)
+
+	objects := []*ResourceObject{}
+	for _, path := range overlay.Resources {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		included, err := l.load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, included...)
+	}
+
+	return objects, nil
+}
+
+// applyOverlay applies the patches of the overlay to the resources. Each patch must
+// change at least one resource.
+func applyOverlay(r *ResourceObject, objects []*ResourceObject) error {
+	overlay, err := toOverlay(r)
+	if err != nil {
+		return err
+	}
+
+	for i, p := range overlay.Patches {
+		if p.Target == nil || len(p.Target.Kind) == 0 || len(p.Target.Name) == 0 {
+			return errorsx.G11NError("The patch %d of the overlay in '%s' has no target kind or name.", i+1, r.source)
+		}
+
+		if (p.MergePatch == nil) == (p.JSONPatch == nil) {
+			return errorsx.G11NError("The patch %d of the overlay in '%s' must have either 'mergePatch' or 'jsonPatch'.", i+1, r.source)
+		}
+
+		handler, err := GetKindHandler(p.Target.Kind)
+		if err != nil {
+			return errorsx.G11NError("The patch %d of the overlay in '%s' cannot be applied; err=%v", i+1, r.source, err)
+		}
+
+		matched := false
+		for _, obj := range objects {
+			if NormalizeKind(obj.Kind) != handler.Kind || handler.Name(obj) != p.Target.Name {
+				continue
+			}
+
+			if p.MergePatch != nil {
+				obj.Data = patch.Merge(obj.Data, p.MergePatch)
+			} else if obj.Data, err = patch.Apply(obj.Data, p.JSONPatch); err != nil {
+				return errorsx.G11NError("The patch %d of the overlay in '%s' cannot be applied to the '%s' resource '%s'; err=%v", i+1, r.source, handler.Kind, p.Target.Name, err)
+			}
+
+			matched = true
+		}
+
+		if !matched {
+			return errorsx.G11NError("The patch %d of the overlay in '%s' targets the '%s' resource '%s', which was not found.", i+1, r.source, handler.Kind, p.Target.Name)
+		}
+	}
+
+	return nil
+}
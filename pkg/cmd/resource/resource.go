@@ -1,19 +1,28 @@
 package resource
 
 import (
+	"bytes"
 	"encoding/json"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/ibm-verify/verifyctl/pkg/util/render"
+	"github.com/ibm-verify/verifyctl/pkg/util/secret"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
 	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 )
 
 const (
 	ResourceTypePrefix = "IBMVerify"
+
+	// ListKind is the kind of a list of resources, such as the one printed by 'get'.
+	ListKind = ResourceTypePrefix + "List"
 )
 
 type ResourceObjectList struct {
@@ -26,8 +35,11 @@ type ResourceObjectList struct {
 type ResourceObject struct {
 	Kind       string                  `json:"kind" yaml:"kind"`
 	APIVersion string                  `json:"apiVersion" yaml:"apiVersion"`
-	Metadata   *ResourceObjectMetadata `json:"metadata" yaml:"metadata"`
+	Metadata   *ResourceObjectMetadata `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 	Data       interface{}             `json:"data" yaml:"data"`
+
+	// source is the file the resource was read from.
+	source string
 }
 
 type ResourceObjectMetadata struct {
@@ -45,19 +57,8 @@ func (r *ResourceObject) LoadFromFile(cmd *cobra.Command, file string, format st
 	ctx := cmd.Context()
 	vc := contextx.GetVerifyContext(ctx)
 
-	var b []byte
-	var err error
-
-	if file == "-" {
-		// read from stdin
-		b, err = io.ReadAll(os.Stdin)
-	} else {
-		// get the contents of the file
-		b, err = os.ReadFile(file)
-	}
-
+	b, err := readFile(cmd, file)
 	if err != nil {
-		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", file, err)
 		return err
 	}
 
@@ -84,5 +85,375 @@ func (r *ResourceObject) LoadFromFile(cmd *cobra.Command, file string, format st
 		}
 	}
 
+	// resolve the secret references, so that the files can be committed without secrets
+	if _, err := secret.ResolveData(r.Data); err != nil {
+		vc.Logger.Errorf("unable to resolve the secrets; err=%v", err)
+		return err
+	}
+
 	return nil
 }
+
+// Source returns the file the resource was read from, if any.
+func (r *ResourceObject) Source() string {
+	return r.source
+}
+
+// LoadObjects reads the resources from the file, or from the files in the directory
+// with the 'json', 'yml' or 'yaml' extension. Subdirectories are read as well if
+// recursive is true. The file name '-' reads from stdin.
+//
+// A YAML file can hold several documents separated by '---', and each document
+// can be a resource or an 'IBMVerifyList' of resources. The resources are returned
+// in the order of the files, sorted by name, and the documents within them.
+// Overlays are replaced by the resources they include and their patches are
+// applied. See Overlay.
+//
+// The files are rendered as templates before they are parsed if the command has
+// the template flags and they are used. See AddTemplateFlags.
+func LoadObjects(cmd *cobra.Command, path string, recursive bool) ([]*ResourceObject, error) {
+	objects, err := BuildObjects(cmd, path, recursive)
+	if err != nil {
+		return nil, err
+	}
+
+	vc := contextx.GetVerifyContext(cmd.Context())
+	for _, r := range objects {
+		// resolve the secret references, so that the files can be committed without secrets
+		if _, err := secret.ResolveData(r.Data); err != nil {
+			vc.Logger.Errorf("unable to resolve the secrets; filename=%s, err=%v", r.source, err)
+			return nil, errorsx.G11NError("Unable to read the resources in '%s'; err=%v", r.source, err)
+		}
+	}
+
+	return objects, nil
+}
+
+// LoadDataFile reads a file that holds the data of a resource of the kind, which is
+// the format read by the commands of the resource types, such as 'create user'. Some
+// of them, such as 'replace application', read a resource instead, in which case its
+// data and metadata are used. The file is rendered as a template if the template flags
+// are used, and the secret references are resolved.
+func LoadDataFile(cmd *cobra.Command, file string, kind string) (*ResourceObject, error) {
+	vc := contextx.GetVerifyContext(cmd.Context())
+	b, err := readFile(cmd, file)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is parsed as YAML
+	data := map[string]interface{}{}
+	if err := yaml.Unmarshal(b, &data); err != nil {
+		vc.Logger.Errorf("unable to unmarshal the data; filename=%s, err=%v", file, err)
+		return nil, err
+	}
+
+	var metadata *ResourceObjectMetadata
+	if inner, ok := data["data"].(map[string]interface{}); ok && data["kind"] != nil {
+		if m, ok := data["metadata"].(map[string]interface{}); ok {
+			metadata = &ResourceObjectMetadata{}
+			if err := decodeData(cmd.Context(), m, metadata); err != nil {
+				return nil, err
+			}
+		}
+
+		data = inner
+	}
+
+	if _, err := secret.ResolveData(data); err != nil {
+		vc.Logger.Errorf("unable to resolve the secrets; filename=%s, err=%v", file, err)
+		return nil, errorsx.G11NError("Unable to read the resource in '%s'; err=%v", file, err)
+	}
+
+	return &ResourceObject{
+		Kind:     kind,
+		Metadata: metadata,
+		Data:     data,
+		source:   file,
+	}, nil
+}
+
+// ReadDataFile reads a file in the format of the commands of the resource types in
+// the same way as LoadDataFile, and returns its contents as YAML with the secret
+// references resolved, so that the commands can decode it into their models. The
+// whole file is returned, including the kind and metadata if it holds a resource.
+func ReadDataFile(cmd *cobra.Command, file string) ([]byte, error) {
+	vc := contextx.GetVerifyContext(cmd.Context())
+	b, err := readFile(cmd, file)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is parsed as YAML
+	var data interface{}
+	if err := yaml.Unmarshal(b, &data); err != nil {
+		vc.Logger.Errorf("unable to unmarshal the data; filename=%s, err=%v", file, err)
+		return nil, err
+	}
+
+	if _, err := secret.ResolveData(data); err != nil {
+		vc.Logger.Errorf("unable to resolve the secrets; filename=%s, err=%v", file, err)
+		return nil, errorsx.G11NError("Unable to read the resource in '%s'; err=%v", file, err)
+	}
+
+	b, err = yaml.Marshal(data)
+	if err != nil {
+		vc.Logger.Errorf("unable to marshal the data; filename=%s, err=%v", file, err)
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// readFile returns the contents of the file, rendered as a template if the command
+// has the template flags and they are used. The file name '-' reads from stdin.
+func readFile(cmd *cobra.Command, file string) ([]byte, error) {
+	vc := contextx.GetVerifyContext(cmd.Context())
+
+	var b []byte
+	var err error
+	if file == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(file)
+	}
+
+	if err != nil {
+		vc.Logger.Errorf("unable to read file; filename=%s, err=%v", file, err)
+		return nil, err
+	}
+
+	values, err := templateValues(cmd)
+	if err != nil {
+		vc.Logger.Errorf("unable to read the template values; err=%v", err)
+		return nil, err
+	}
+
+	if values != nil {
+		if b, err = render.Render(file, b, values); err != nil {
+			vc.Logger.Errorf("unable to render the file; filename=%s, err=%v", file, err)
+			return nil, errorsx.G11NError("Unable to render '%s'; err=%v", file, err)
+		}
+	}
+
+	return b, nil
+}
+
+// BuildObjects reads the resources in the same way as LoadObjects, but the secret
+// references are not resolved, so that the resources can be printed.
+func BuildObjects(cmd *cobra.Command, path string, recursive bool) ([]*ResourceObject, error) {
+	vc := contextx.GetVerifyContext(cmd.Context())
+
+	values, err := templateValues(cmd)
+	if err != nil {
+		vc.Logger.Errorf("unable to read the template values; err=%v", err)
+		return nil, err
+	}
+
+	l := &loader{
+		cmd:       cmd,
+		values:    values,
+		recursive: recursive,
+		expanding: map[string]bool{},
+	}
+
+	objects, err := l.load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(objects) == 0 {
+		return nil, errorsx.G11NError("No resources were found in '%s'.", path)
+	}
+
+	return objects, nil
+}
+
+// loader reads the resources in the files and the ones included by overlays.
+type loader struct {
+	cmd       *cobra.Command
+	values    render.Values
+	recursive bool
+
+	// expanding holds the overlay files being read, to detect cycles.
+	expanding map[string]bool
+}
+
+func (l *loader) load(path string) ([]*ResourceObject, error) {
+	vc := contextx.GetVerifyContext(l.cmd.Context())
+
+	files := []string{path}
+	if path != "-" {
+		info, err := os.Stat(path)
+		if err != nil {
+			vc.Logger.Errorf("unable to read file; filename=%s, err=%v", path, err)
+			return nil, err
+		}
+
+		if info.IsDir() {
+			if files, err = listFiles(path, l.recursive); err != nil {
+				vc.Logger.Errorf("unable to list the files; dir=%s, err=%v", path, err)
+				return nil, err
+			}
+		}
+	}
+
+	objects := []*ResourceObject{}
+	overlays := []*ResourceObject{}
+	for _, file := range files {
+		var b []byte
+		var err error
+		if file == "-" {
+			b, err = io.ReadAll(os.Stdin)
+		} else {
+			b, err = os.ReadFile(file)
+		}
+
+		if err != nil {
+			vc.Logger.Errorf("unable to read file; filename=%s, err=%v", file, err)
+			return nil, err
+		}
+
+		if l.values != nil {
+			if b, err = render.Render(file, b, l.values); err != nil {
+				vc.Logger.Errorf("unable to render the file; filename=%s, err=%v", file, err)
+				return nil, errorsx.G11NError("Unable to render '%s'; err=%v", file, err)
+			}
+		}
+
+		fileObjects, err := parseObjects(b, strings.HasSuffix(file, ".json"))
+		if err != nil {
+			vc.Logger.Errorf("unable to unmarshal the objects; filename=%s, err=%v", file, err)
+			return nil, errorsx.G11NError("Unable to read the resources in '%s'; err=%v", file, err)
+		}
+
+		for _, r := range fileObjects {
+			r.source = file
+			if r.Kind != OverlayKind {
+				objects = append(objects, r)
+				continue
+			}
+
+			included, err := l.include(r)
+			if err != nil {
+				return nil, err
+			}
+
+			objects = append(objects, included...)
+			overlays = append(overlays, r)
+		}
+	}
+
+	// the patches apply to all the resources read, including the ones in other files
+	for _, r := range overlays {
+		if err := applyOverlay(r, objects); err != nil {
+			vc.Logger.Errorf("unable to apply the overlay; filename=%s, err=%v", r.source, err)
+			return nil, err
+		}
+	}
+
+	return objects, nil
+}
+
+// listFiles returns the resource files in the directory sorted by name.
+func listFiles(dir string, recursive bool) ([]string, error) {
+	files := []string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path != dir && !recursive {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		switch filepath.Ext(path) {
+		case ".json", ".yml", ".yaml":
+			files = append(files, path)
+		}
+
+		return nil
+	})
+
+	return files, err
+}
+
+// parseObjects reads the documents in the file and expands the lists.
+func parseObjects(b []byte, isJSON bool) ([]*ResourceObject, error) {
+	docs := []map[string]interface{}{}
+	if isJSON {
+		decoder := json.NewDecoder(bytes.NewReader(b))
+		for {
+			doc := map[string]interface{}{}
+			if err := decoder.Decode(&doc); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+
+			docs = append(docs, doc)
+		}
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(b))
+		for {
+			doc := map[string]interface{}{}
+			if err := decoder.Decode(&doc); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+
+			// skip empty documents, such as a trailing '---'
+			if len(doc) > 0 {
+				docs = append(docs, doc)
+			}
+		}
+	}
+
+	objects := []*ResourceObject{}
+	for _, doc := range docs {
+		if doc["kind"] != ListKind {
+			r, err := toObject(doc)
+			if err != nil {
+				return nil, err
+			}
+
+			objects = append(objects, r)
+			continue
+		}
+
+		items, ok := doc["items"].([]interface{})
+		if !ok && doc["items"] != nil {
+			return nil, errorsx.G11NError("The 'items' of the list must be an array.")
+		}
+
+		for _, item := range items {
+			r, err := toObject(item)
+			if err != nil {
+				return nil, err
+			}
+
+			objects = append(objects, r)
+		}
+	}
+
+	return objects, nil
+}
+
+func toObject(doc interface{}) (*ResourceObject, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ResourceObject{}
+	if err := json.Unmarshal(b, r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,48 @@
+package resource
+
+import (
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/util/render"
+	"github.com/spf13/cobra"
+)
+
+const (
+	templateFlag = "template"
+	valuesFlag   = "values"
+	setFlag      = "set"
+)
+
+// AddTemplateFlags adds the flags that provide the values used to render the
+// resource files as templates. The files are only rendered when one of the flags
+// is used, since they may hold text that looks like a template action.
+func AddTemplateFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(templateFlag, false, i18n.Translate("Render the resource files as templates. It is implied by the 'values' and 'set' flags."))
+	cmd.Flags().StringSlice(valuesFlag, nil, i18n.Translate("Path to a YAML file with the values used to render the resource files as templates. The files are merged in the order they are specified."))
+	cmd.Flags().StringArray(setFlag, nil, i18n.Translate("Set a value used to render the resource files as templates, in the form 'key=value'. Use dots to set nested values. It overrides the values in the files."))
+}
+
+// templateValues returns the values used to render the resource files, or nil if
+// the files are not rendered.
+func templateValues(cmd *cobra.Command) (render.Values, error) {
+	valuesFiles, _ := cmd.Flags().GetStringSlice(valuesFlag)
+	setValues, _ := cmd.Flags().GetStringArray(setFlag)
+	enabled, _ := cmd.Flags().GetBool(templateFlag)
+	if !enabled && len(valuesFiles) == 0 && len(setValues) == 0 {
+		return nil, nil
+	}
+
+	values := render.Values{}
+	for _, file := range valuesFiles {
+		if err := values.LoadFile(file); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, expr := range setValues {
+		if err := values.Set(expr); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
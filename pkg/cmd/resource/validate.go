@@ -0,0 +1,252 @@
+package resource
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ibm-verify/verifyctl/pkg/util/render"
+	"github.com/ibm-verify/verifyctl/pkg/util/schema"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+)
+
+// overlayAPIVersions are the versions of overlays accepted in resource files.
+var overlayAPIVersions = []string{"1.0"}
+
+// ValidationError is a problem found in a resource file.
+type ValidationError struct {
+	File string
+
+	// Line and Column are the position in the file, starting at 1. They are 0 if
+	// the file cannot be parsed and the position is not known.
+	Line   int
+	Column int
+
+	// Path is the path to the value, such as 'data.providers.oidc'.
+	Path string
+
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.File, e.Message)
+	}
+
+	if len(e.Path) == 0 {
+		return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Message)
+	}
+
+	return fmt.Sprintf("%s:%d:%d: %s: %s", e.File, e.Line, e.Column, e.Path, e.Message)
+}
+
+// ValidateObjects checks the resources in the files without connecting to the tenant.
+// The files are read in the same way as LoadObjects, but overlays are not applied.
+// It checks that the kind and API version are supported and that the data has no
+// unknown properties, values of the wrong type or missing required properties.
+//
+// It returns the problems found and the number of resources checked. An error is
+// returned if the files cannot be read.
+func ValidateObjects(cmd *cobra.Command, path string, recursive bool) ([]*ValidationError, int, error) {
+	vc := contextx.GetVerifyContext(cmd.Context())
+
+	values, err := templateValues(cmd)
+	if err != nil {
+		vc.Logger.Errorf("unable to read the template values; err=%v", err)
+		return nil, 0, err
+	}
+
+	files := []string{path}
+	if path != "-" {
+		info, err := os.Stat(path)
+		if err != nil {
+			vc.Logger.Errorf("unable to read file; filename=%s, err=%v", path, err)
+			return nil, 0, err
+		}
+
+		if info.IsDir() {
+			if files, err = listFiles(path, recursive); err != nil {
+				vc.Logger.Errorf("unable to list the files; dir=%s, err=%v", path, err)
+				return nil, 0, err
+			}
+		}
+	}
+
+	v := &validator{}
+	for _, file := range files {
+		var b []byte
+		var err error
+		if file == "-" {
+			b, err = io.ReadAll(os.Stdin)
+		} else {
+			b, err = os.ReadFile(file)
+		}
+
+		if err != nil {
+			vc.Logger.Errorf("unable to read file; filename=%s, err=%v", file, err)
+			return nil, 0, err
+		}
+
+		if values != nil {
+			if b, err = render.Render(file, b, values); err != nil {
+				v.errs = append(v.errs, &ValidationError{File: file, Message: err.Error()})
+				continue
+			}
+		}
+
+		v.file = file
+		v.validateFile(b)
+	}
+
+	return v.errs, v.count, nil
+}
+
+// validator collects the problems found in the files.
+type validator struct {
+	file  string
+	count int
+	errs  []*ValidationError
+}
+
+func (v *validator) add(node *yaml.Node, path string, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{
+		File:    v.file,
+		Line:    node.Line,
+		Column:  node.Column,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) addSchemaErrors(errs []*schema.Error) {
+	for _, e := range errs {
+		v.errs = append(v.errs, &ValidationError{
+			File:    v.file,
+			Line:    e.Line,
+			Column:  e.Column,
+			Path:    e.Path,
+			Message: e.Message,
+		})
+	}
+}
+
+// validateFile checks the documents in the file. JSON is parsed as YAML, so that
+// the positions are known.
+func (v *validator) validateFile(b []byte) {
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		doc := &yaml.Node{}
+		if err := decoder.Decode(doc); err == io.EOF {
+			return
+		} else if err != nil {
+			v.errs = append(v.errs, &ValidationError{File: v.file, Message: err.Error()})
+			return
+		}
+
+		if len(doc.Content) == 0 {
+			continue
+		}
+
+		root := doc.Content[0]
+		if root.Kind == yaml.MappingNode && len(root.Content) == 0 {
+			// skip empty documents
+			continue
+		}
+
+		if root.Kind != yaml.MappingNode {
+			v.add(root, "", "expected a resource, but found %s", strings.TrimPrefix(root.ShortTag(), "!!"))
+			continue
+		}
+
+		if kind := mappingValue(root, "kind"); kind != nil && kind.Value == ListKind {
+			v.validateList(root)
+			continue
+		}
+
+		v.validateObject(root, "")
+	}
+}
+
+func (v *validator) validateList(node *yaml.Node) {
+	s := schema.FromType(reflect.TypeOf(ResourceObjectList{}), nil)
+	s.Properties["items"] = &schema.Schema{Type: schema.TypeArray, Items: &schema.Schema{}}
+	s.Required = []string{"kind", "items"}
+	v.addSchemaErrors(schema.Validate(node, s, ""))
+
+	items := mappingValue(node, "items")
+	if items == nil || items.Kind != yaml.SequenceNode {
+		return
+	}
+
+	for i, item := range items.Content {
+		path := fmt.Sprintf("items[%d]", i)
+		if item.Kind != yaml.MappingNode {
+			v.add(item, path, "expected a resource, but found %s", strings.TrimPrefix(item.ShortTag(), "!!"))
+			continue
+		}
+
+		v.validateObject(item, path)
+	}
+}
+
+func (v *validator) validateObject(node *yaml.Node, path string) {
+	v.count++
+
+	s := schema.FromType(reflect.TypeOf(ResourceObject{}), nil)
+	s.Required = []string{"kind", "apiVersion", "data"}
+	v.addSchemaErrors(schema.Validate(node, s, path))
+
+	kind := mappingValue(node, "kind")
+	if kind == nil || kind.Kind != yaml.ScalarNode || len(kind.Value) == 0 {
+		return
+	}
+
+	apiVersions := overlayAPIVersions
+	dataSchema := OverlaySchema()
+	if kind.Value != OverlayKind {
+		handler, err := GetKindHandler(kind.Value)
+		if err != nil {
+			v.add(kind, join(path, "kind"), "the kind '%s' is not supported", kind.Value)
+			return
+		}
+
+		// the data is checked in the same way as KindHandler.Validate
+		data := mappingValue(node, "data")
+		apiVersions = handler.APIVersions
+		dataSchema = handler.dataSchema(data != nil && data.Kind == yaml.MappingNode && mappingValue(data, "scimPatch") != nil)
+	}
+
+	if apiVersion := mappingValue(node, "apiVersion"); apiVersion != nil && apiVersion.ShortTag() == "!!str" && !slices.Contains(apiVersions, apiVersion.Value) {
+		v.add(apiVersion, join(path, "apiVersion"), "the version '%s' is not supported; expected %s", apiVersion.Value, strings.Join(apiVersions, " or "))
+	}
+
+	if data := mappingValue(node, "data"); data != nil {
+		v.addSchemaErrors(schema.Validate(data, dataSchema, join(path, "data")))
+	}
+}
+
+// mappingValue returns the value of the key in the mapping, or nil if there is none.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func join(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -1,15 +1,18 @@
 package set
 
 import (
+	"archive/zip"
+	"bytes"
 	"io"
 	"os"
 	"path/filepath"
 
-	"github.com/ibm-verify/verify-sdk-go/pkg/config/branding"
 	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
 	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
 	"github.com/ibm-verify/verifyctl/pkg/config"
 	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/dryrun"
 	"github.com/ibm-verify/verifyctl/pkg/util/templates"
 	"github.com/spf13/cobra"
 )
@@ -17,13 +20,22 @@ import (
 const (
 	themesUsage         = `theme [flags]`
 	themesMessagePrefix = "SetTheme"
-	themesEntitlements  = "manageTemplates (Manage templates and themes)"
 	themeResourceName   = "theme"
 )
 
 var (
+	themesEntitlements = config.Entitlements{config.EntitlementManageTemplates}
+
 	themesLongDesc = templates.LongDesc(cmdutil.TranslateLongDesc(themesMessagePrefix, `
 		Update a theme or theme files.
+
+Use '--dry-run' to preview the change without making it:
+
+  - 'client' prints the request that would update the theme instead of sending it. The theme archive
+    and the files are replaced by their size.
+  - 'server' checks that the files can be read and that a theme archive is a zip file, and reads the
+    theme from the tenant to check that it exists. Verify does not offer a validation-only endpoint
+    for themes, so the files themselves are not checked by the tenant.
 		
 Resources managed on Verify have specific entitlements, so ensure that the application or API client used
 with the 'auth' command is configured with the appropriate entitlements.
@@ -41,7 +53,10 @@ You can identify the entitlement required by running:
 		verifyctl set theme --id=mythemeid --dir=./mytheme
 		
 		# Upload a theme template file
-		verifyctl set theme --id=mythemeid --file=./mylogo.png --path=common/logo/default/logo.png`))
+		verifyctl set theme --id=mythemeid --file=./mylogo.png --path=common/logo/default/logo.png
+
+		# Print the request that would update the theme
+		verifyctl set theme --id=mythemeid --dir=./mytheme --dry-run=client`))
 )
 
 type themesOptions struct {
@@ -83,6 +98,7 @@ func (o *themesOptions) AddFlags(cmd *cobra.Command) {
 	o.addCommonFlags(cmd, themeResourceName)
 	cmd.Flags().StringVarP(&o.path, "template", "T", "", i18n.Translate("Template file path, including the locale. This is only meant to be used when updating a single file. The 'format' flag is assumed to be 'raw' in this case."))
 	cmd.Flags().StringVar(&o.directory, "dir", "", i18n.Translate("Path to the directory where the theme is unpacked. The contents of the directory will be compressed and uploaded as the theme."))
+	dryrun.AddFlag(cmd)
 }
 
 func (o *themesOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -113,32 +129,85 @@ func (o *themesOptions) Validate(cmd *cobra.Command, args []string) error {
 
 func (o *themesOptions) Run(cmd *cobra.Command, args []string) error {
 	if o.entitlements {
-		cmdutil.WriteString(cmd, entitlementsMessage+"  "+themesEntitlements)
+		cmdutil.WriteString(cmd, entitlementsMessage+themesEntitlements.String())
 		return nil
 	}
 
-	_, err := o.config.SetAuthToContext(cmd.Context())
+	mode, err := dryrun.Mode(cmd)
 	if err != nil {
 		return err
 	}
 
+	_, err = o.config.SetAuthToContext(cmd.Context(), themesEntitlements...)
+	if err != nil {
+		return err
+	}
+
+	switch mode {
+	case dryrun.Client:
+		cmd.SetContext(dryrun.WithClient(cmd.Context(), cmd.OutOrStdout()))
+	case dryrun.Server:
+		return o.checkTheme(cmd)
+	}
+
 	// invoke the operation
-	return o.handleSingleThemeCommand(cmd, args)
+	if err := o.handleSingleThemeCommand(cmd, args); err != nil {
+		return err
+	}
+
+	if mode == dryrun.Client {
+		cmdutil.WriteString(cmd, i18n.TranslateWithArgs("Theme '%s' updated", o.id)+dryrun.Suffix(mode))
+	}
+
+	return nil
 }
 
-func (o *themesOptions) handleSingleThemeCommand(cmd *cobra.Command, _ []string) error {
-	c := branding.NewThemeClient()
-	if len(o.path) > 0 {
-		// get the contents of the file
-		b, err := os.ReadFile(o.file)
-		if err != nil {
-			return err
+// checkTheme checks that the files to upload can be read, that a theme is a zip
+// file and that the theme exists on the tenant.
+func (o *themesOptions) checkTheme(cmd *cobra.Command) error {
+	b, err := o.themeData(cmd)
+	if err != nil {
+		return err
+	}
+
+	if len(o.path) == 0 {
+		if _, err := zip.NewReader(bytes.NewReader(b), int64(len(b))); err != nil {
+			return errorsx.G11NError("The theme must be a zip file; err=%v", err)
 		}
+	}
+
+	c := resource.NewThemeClient(cmd.Context())
+	if _, _, err := c.GetTheme(cmd.Context(), o.id, true); err != nil {
+		return err
+	}
 
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("Theme '%s' exists and the files are valid", o.id)+dryrun.Suffix(dryrun.Server))
+	return nil
+}
+
+func (o *themesOptions) handleSingleThemeCommand(cmd *cobra.Command, _ []string) error {
+	b, err := o.themeData(cmd)
+	if err != nil {
+		return err
+	}
+
+	c := resource.NewThemeClient(cmd.Context())
+	if len(o.path) > 0 {
 		// update a single file
 		return c.UpdateFile(cmd.Context(), o.id, o.path, b)
 	}
 
+	return c.UpdateTheme(cmd.Context(), o.id, b, nil)
+}
+
+// themeData returns the contents of the file to upload, or the theme compressed
+// as a zip file.
+func (o *themesOptions) themeData(cmd *cobra.Command) ([]byte, error) {
+	if len(o.path) > 0 {
+		// get the contents of the file
+		return os.ReadFile(o.file)
+	}
+
 	var zipBuffer []byte
 	var err error
 	if len(o.directory) > 0 {
@@ -153,8 +222,8 @@ func (o *themesOptions) handleSingleThemeCommand(cmd *cobra.Command, _ []string)
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return c.UpdateTheme(cmd.Context(), o.id, zipBuffer, nil)
+	return zipBuffer, nil
 }
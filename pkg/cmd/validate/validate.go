@@ -0,0 +1,131 @@
+package validate
+
+import (
+	"io"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/cmd/resource"
+	"github.com/ibm-verify/verifyctl/pkg/config"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"github.com/ibm-verify/verifyctl/pkg/util/templates"
+	"github.com/spf13/cobra"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	usage         = "validate -f=FILENAME [options]"
+	messagePrefix = "Validate"
+)
+
+var (
+	shortDesc = cmdutil.TranslateShortDesc(messagePrefix, "Check Verify resource files without connecting to the tenant.")
+
+	longDesc = templates.LongDesc(cmdutil.TranslateLongDesc(messagePrefix, `
+		Check Verify resources in a file or directory without connecting to the tenant.
+
+Each resource is checked for a supported 'kind' and 'apiVersion', and its data is checked against
+the schema of the kind, which is generated from the types used to send it to the tenant. Unknown
+properties, values of the wrong type and missing required properties are reported with the file,
+line and column, such as:
+
+  app.yaml:12:5: data.providers.oidc.grantType: unknown property
+
+The properties of a resource can be found using:
+
+  verifyctl explain [resource-type]
+
+Overlays are checked, but their patches are not applied, so the files they include are only checked
+if they are in the input as well. The files are read in the same way as 'apply'. For more
+information:
+
+  verifyctl apply -h
+
+The command exits with status 1 if any problem is found.`))
+
+	examples = templates.Examples(cmdutil.TranslateExamples(messagePrefix, `
+		# Check an application
+		verifyctl validate -f=./app-1098012.yaml
+
+		# Check the resources in a directory and its subdirectories
+		verifyctl validate -f=./resources -R`))
+)
+
+type options struct {
+	file      string
+	recursive bool
+
+	config *config.CLIConfig
+}
+
+func NewCommand(config *config.CLIConfig, streams io.ReadWriter, groupID string) *cobra.Command {
+	o := &options{
+		config: config,
+	}
+
+	cmd := &cobra.Command{
+		Use:                   usage,
+		Short:                 shortDesc,
+		Long:                  longDesc,
+		Example:               examples,
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitOnError(cmd, o.Complete(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Validate(cmd, args))
+			cmdutil.ExitOnError(cmd, o.Run(cmd, args))
+		},
+		GroupID: groupID,
+	}
+
+	cmd.SetOut(streams)
+	cmd.SetErr(streams)
+	cmd.SetIn(streams)
+
+	o.AddFlags(cmd)
+
+	return cmd
+}
+
+func (o *options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", i18n.Translate("Path to the file or directory that contains the input data. JSON and YAML formats are supported and the files are expected to be named with the appropriate extension: json, yml or yaml. Use '-' to read from stdin."))
+	cmd.Flags().BoolVarP(&o.recursive, "recursive", "R", o.recursive, i18n.Translate("Read the files in the subdirectories as well when the input is a directory."))
+	resource.AddTemplateFlags(cmd)
+}
+
+func (o *options) Complete(cmd *cobra.Command, args []string) error {
+	return nil
+}
+
+func (o *options) Validate(cmd *cobra.Command, args []string) error {
+	if len(o.file) == 0 {
+		return errorsx.G11NError("'file' option is required.")
+	}
+
+	return nil
+}
+
+func (o *options) Run(cmd *cobra.Command, args []string) error {
+	vc := contextx.GetVerifyContext(cmd.Context())
+
+	errs, count, err := resource.ValidateObjects(cmd, o.file, o.recursive)
+	if err != nil {
+		vc.Logger.Errorf("unable to read the resources; err=%v", err)
+		return err
+	}
+
+	for _, e := range errs {
+		cmdutil.WriteString(cmd, e.Error())
+	}
+
+	if len(errs) > 0 {
+		return errorsx.G11NError("Found %d problems in '%s'.", len(errs), o.file)
+	}
+
+	if count == 0 {
+		return errorsx.G11NError("No resources were found in '%s'.", o.file)
+	}
+
+	cmdutil.WriteString(cmd, i18n.TranslateWithArgs("%d resources are valid.", count))
+	return nil
+}
@@ -13,23 +13,68 @@ import (
 )
 
 const (
-	apiVersion  = "1.0"
-	kind        = "Config"
-	fileName    = "config"
-	defaultPerm = os.ModePerm
+	apiVersion = "2.0"
+	kind       = "Config"
+	fileName   = "config"
 )
 
 type CLIConfig struct {
-	APIVersion    string        `yaml:"apiVersion"`
-	Kind          string        `yaml:"kind"`
-	CurrentTenant string        `yaml:"tenant"`
-	Auth          []*AuthConfig `yaml:"auth"`
+	APIVersion     string           `yaml:"apiVersion" json:"apiVersion"`
+	Kind           string           `yaml:"kind" json:"kind"`
+	CurrentTenant  string           `yaml:"tenant" json:"tenant"`
+	CurrentContext string           `yaml:"currentContext,omitempty" json:"currentContext,omitempty"`
+	Auth           []*AuthConfig    `yaml:"auth" json:"auth"`
+	Contexts       []*ContextConfig `yaml:"contexts,omitempty" json:"contexts,omitempty"`
+
+	// CredentialStore is where tokens and client secrets are kept. The config
+	// file holds them unless 'keyring' or 'file' is set.
+	CredentialStore string `yaml:"credentialStore,omitempty" json:"credentialStore,omitempty"`
+
+	// SelectedContext is the context chosen for this invocation using the
+	// '--context' flag. It takes precedence over CurrentContext and is never persisted.
+	SelectedContext string `yaml:"-" json:"-"`
+
+	// TenantOverride and TokenOverride are set using the '--tenant' and '--token'
+	// flags. They are used instead of the sessions in the file and are never persisted.
+	TenantOverride string `yaml:"-" json:"-"`
+	TokenOverride  string `yaml:"-" json:"-"`
+
+	// removedRefs are credentials of deleted auth entries that are removed
+	// from the credential store when the file is persisted.
+	removedRefs []string
+
+	// migratedFrom is the version of the file before it was migrated on load and
+	// original holds its contents. A backup is written when the file is persisted.
+	migratedFrom string
+	original     []byte
 }
 
 type AuthConfig struct {
-	Tenant string `yaml:"tenant"`
-	Token  string `yaml:"token"`
-	User   bool   `yaml:"isUser"`
+	Name         string        `yaml:"name,omitempty" json:"name,omitempty"`
+	Tenant       string        `yaml:"tenant" json:"tenant"`
+	Token        string        `yaml:"token" json:"token"`
+	RefreshToken string        `yaml:"refreshToken,omitempty" json:"refreshToken,omitempty"`
+	ExpiresAt    int64         `yaml:"expiresAt,omitempty" json:"expiresAt,omitempty"`
+	User         bool          `yaml:"isUser" json:"isUser"`
+	Client       *ClientConfig `yaml:"client,omitempty" json:"client,omitempty"`
+
+	// GrantedScopes are the scopes and entitlements granted with the token, saved
+	// when it is issued, so that they can be checked when the token is opaque.
+	GrantedScopes []string `yaml:"grantedScopes,omitempty" json:"grantedScopes,omitempty"`
+
+	// Exec is the credential plugin that provides the token. The token is
+	// cached outside the config file.
+	Exec *ExecConfig `yaml:"exec,omitempty" json:"exec,omitempty"`
+
+	// CredentialRef identifies the secrets in the credential store. It is
+	// empty when the secrets are in the config file.
+	CredentialRef string `yaml:"credentialRef,omitempty" json:"credentialRef,omitempty"`
+
+	credentialLoaded bool
+
+	// ephemeral entries are built from flags or environment variables and are
+	// not saved to the config file.
+	ephemeral bool
 }
 
 func NewCLIConfig() *CLIConfig {
@@ -41,9 +86,13 @@ func NewCLIConfig() *CLIConfig {
 }
 
 func (o *CLIConfig) AddAuth(config *AuthConfig) {
+	if len(config.Name) == 0 {
+		config.Name = config.Tenant
+	}
+
 	// check if it already exists and replace if so
 	for _, c := range o.Auth {
-		if c.Tenant == config.Tenant {
+		if c.Name == config.Name {
 			// replace
 			c.Merge(config)
 			return
@@ -58,6 +107,16 @@ func (o *CLIConfig) SetCurrentTenant(tenant string) {
 	o.CurrentTenant = tenant
 }
 
+func (o *CLIConfig) GetAuth(name string) *AuthConfig {
+	for _, c := range o.Auth {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
 func (o *CLIConfig) LoadFromFile() (*CLIConfig, error) {
 	configDir, err := cmdutil.GetDir()
 	if err != nil {
@@ -75,33 +134,115 @@ func (o *CLIConfig) LoadFromFile() (*CLIConfig, error) {
 		return o, err
 	}
 
-	if err = yaml.Unmarshal(data, o); err != nil {
-		return o, err
+	if err = o.decode(data); err != nil {
+		return o, errorsx.G11NError("Unable to load the configuration file '%s'; err=%v", configFile, err)
 	}
 
 	return o, nil
 }
 
+// PersistFile writes the configuration to the file, replacing its contents. Use
+// Update to apply changes to the latest contents of the file instead.
 func (o *CLIConfig) PersistFile() (*CLIConfig, error) {
-	data, err := yaml.Marshal(o)
+	configDir, err := cmdutil.CreateOrGetDir()
 	if err != nil {
 		return o, err
 	}
 
-	configDir, err := cmdutil.CreateOrGetDir()
+	unlock, err := lockConfigDir(configDir)
 	if err != nil {
 		return o, err
 	}
 
-	configFile := filepath.Join(configDir, fileName)
-	if err = os.WriteFile(configFile, data, defaultPerm); err != nil {
-		return o, err
+	defer unlock()
+	return o, o.persist(configDir)
+}
+
+// Update re-reads the file, applies the changes and persists the result while
+// holding a lock on the config directory. This prevents concurrent invocations,
+// such as parallel CI jobs, from losing each other's changes. The configuration
+// is refreshed with the result.
+func (o *CLIConfig) Update(update func(c *CLIConfig) error) error {
+	configDir, err := cmdutil.CreateOrGetDir()
+	if err != nil {
+		return err
 	}
 
-	return o, nil
+	unlock, err := lockConfigDir(configDir)
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	c := NewCLIConfig()
+	c.SelectedContext = o.SelectedContext
+	c.TenantOverride = o.TenantOverride
+	c.TokenOverride = o.TokenOverride
+	if _, err := c.LoadFromFile(); err != nil {
+		return err
+	}
+
+	if err := update(c); err != nil {
+		return err
+	}
+
+	if err := c.persist(configDir); err != nil {
+		return err
+	}
+
+	*o = *c
+	return nil
+}
+
+func (o *CLIConfig) persist(configDir string) error {
+	o.APIVersion = apiVersion
+	o.Kind = kind
+	if err := o.writeBackup(configDir); err != nil {
+		return err
+	}
+
+	// move the secrets to the credential store, if one is used
+	c, err := o.saveCredentials()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	return writeSecureFile(filepath.Join(configDir, fileName), data)
 }
 
 func (o *CLIConfig) GetCurrentAuth() (*AuthConfig, error) {
+	auth, err := o.getOverrideAuth()
+	if err != nil {
+		return nil, err
+	}
+
+	if auth == nil {
+		auth, err = o.getCurrentAuth()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the secrets are only read from the credential store when needed
+	if err := o.loadCredential(auth); err != nil {
+		return nil, err
+	}
+
+	return auth, nil
+}
+
+func (o *CLIConfig) getCurrentAuth() (*AuthConfig, error) {
+	if name := o.contextName(); len(name) > 0 {
+		return o.getContextAuth(name)
+	}
+
 	for _, c := range o.Auth {
 		if c.Tenant == o.CurrentTenant {
 			return c, nil
@@ -111,22 +252,119 @@ func (o *CLIConfig) GetCurrentAuth() (*AuthConfig, error) {
 	return nil, errorsx.G11NError("No login session available. Use:\n  verifyctl login -h")
 }
 
-func (o *CLIConfig) SetAuthToContext(ctx context.Context) (*AuthConfig, error) {
+// SetAuthToContext sets the tenant and token of the current session on the context.
+// The token must be granted at least one of the entitlements, if any are provided.
+func (o *CLIConfig) SetAuthToContext(ctx context.Context, entitlements ...string) (*AuthConfig, error) {
 	auth, err := o.GetCurrentAuth()
 	if err != nil {
 		return nil, err
 	}
 
-	// hydrate the verify context with current auth information
+	return o.setAuthToContext(ctx, auth, entitlements)
+}
+
+// SetTenantAuthToContext sets the tenant and token of the session for the context,
+// auth entry or tenant with the name on the context. It is used by commands that
+// work with more than one tenant, so the current context and the overrides using
+// flags and environment variables are ignored.
+func (o *CLIConfig) SetTenantAuthToContext(ctx context.Context, name string, entitlements ...string) (*AuthConfig, error) {
+	var auth *AuthConfig
+	if o.GetContext(name) != nil || o.GetAuth(name) != nil {
+		c, err := o.getContextAuth(name)
+		if err != nil {
+			return nil, err
+		}
+
+		auth = c
+	} else {
+		auths := o.getTenantAuths(name)
+		if len(auths) == 0 {
+			return nil, errorsx.G11NError("No login session available for '%s'. Use:\n  verifyctl login -h", name)
+		}
+
+		if len(auths) > 1 {
+			return nil, errorsx.G11NError("There are several sessions for tenant '%s'. Use the name of a context instead.", name)
+		}
+
+		auth = auths[0]
+	}
+
+	if err := o.loadCredential(auth); err != nil {
+		return nil, err
+	}
+
+	return o.setAuthToContext(ctx, auth, entitlements)
+}
+
+func (o *CLIConfig) setAuthToContext(ctx context.Context, auth *AuthConfig, entitlements []string) (*AuthConfig, error) {
+	// get a token for client credentials provided using environment variables
 	vc := contextx.GetVerifyContext(ctx)
+	if auth.Exec != nil {
+		store, err := NewCredentialStore(o.CredentialStoreName())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := auth.loadExecToken(ctx, store); err != nil {
+			return nil, err
+		}
+	} else if len(auth.Token) == 0 && auth.ephemeral {
+		if err := auth.Renew(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// renew the token silently if it is about to expire
+	if auth.Exec == nil && auth.isExpiring() {
+		if err := auth.Renew(ctx); err != nil {
+			// continue with the existing token. The request fails with an
+			// appropriate error if the token is no longer valid.
+			vc.Logger.Warnf("unable to renew the token; tenant=%s, err=%v", auth.Tenant, err)
+		} else if !auth.ephemeral {
+			err := o.Update(func(c *CLIConfig) error {
+				// another invocation may have removed the entry
+				if current := c.GetAuth(auth.Name); current != nil {
+					current.Merge(auth)
+				}
+
+				return nil
+			})
+
+			if err != nil {
+				vc.Logger.Warnf("unable to persist the renewed token; tenant=%s, err=%v", auth.Tenant, err)
+			}
+		}
+	}
+
+	// fail before calling the tenant if the token cannot be used
+	if err := auth.CheckEntitlements(ctx, entitlements); err != nil {
+		return nil, err
+	}
+
+	// hydrate the verify context with current auth information
 	vc.Tenant = auth.Tenant
 	vc.Token = auth.Token
 
 	return auth, nil
 }
 
+// IsEphemeral indicates if the entry was built from flags or environment variables
+// instead of being read from the config file.
+func (o *AuthConfig) IsEphemeral() bool {
+	return o.ephemeral
+}
+
 func (o *AuthConfig) Merge(c *AuthConfig) {
+	o.Name = c.Name
 	o.Tenant = c.Tenant
 	o.Token = c.Token
+	o.RefreshToken = c.RefreshToken
+	o.ExpiresAt = c.ExpiresAt
 	o.User = c.User
+	o.Client = c.Client
+	o.GrantedScopes = c.GrantedScopes
+	o.Exec = c.Exec
+
+	// the merged secrets replace those in the credential store
+	o.credentialLoaded = true
 }
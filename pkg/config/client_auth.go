@@ -0,0 +1,308 @@
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/google/uuid"
+	oidc "github.com/ibm-verify/verify-sdk-go/pkg/auth"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	xhttp "github.com/ibm-verify/verifyctl/pkg/util/http"
+	"github.com/ibm-verify/verifyctl/pkg/util/jwk"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// Client authentication methods
+const (
+	AuthTypeClientSecretPost  = "client_secret_post"
+	AuthTypeClientSecretBasic = "client_secret_basic"
+	AuthTypeClientSecretJWT   = "client_secret_jwt"
+	AuthTypePrivateKeyJWT     = "private_key_jwt"
+	AuthTypeTLSClientAuth     = "tls_client_auth"
+
+	// clientAssertionLifetime is how long the client assertion JWT is valid for.
+	clientAssertionLifetime = 5 * time.Minute
+
+	minHMACSecretLength = 32
+)
+
+// AuthTypes lists the supported client authentication methods.
+var AuthTypes = []string{
+	AuthTypeClientSecretPost,
+	AuthTypeClientSecretBasic,
+	AuthTypeClientSecretJWT,
+	AuthTypePrivateKeyJWT,
+	AuthTypeTLSClientAuth,
+}
+
+// ClientConfig holds the client details used to obtain the token so that
+// it can be renewed without the user having to login again. The client secret
+// and private key are only saved when a credential store is used.
+type ClientConfig struct {
+	ClientID     string `yaml:"clientId" json:"clientId"`
+	ClientSecret string `yaml:"clientSecret,omitempty" json:"clientSecret,omitempty"`
+	AuthType     string `yaml:"authType,omitempty" json:"authType,omitempty"`
+
+	// PrivateKey is the JWK used for private_key_jwt. For tls_client_auth, it
+	// holds the key and the client certificate chain in 'x5c'.
+	PrivateKey string     `yaml:"key,omitempty" json:"key,omitempty"`
+	Scopes     []string   `yaml:"scopes,omitempty" json:"scopes,omitempty"`
+	Parameters url.Values `yaml:"params,omitempty" json:"params,omitempty"`
+}
+
+// clientSecretBasic sends the client credentials using HTTP Basic authentication.
+// The OAuth library used for user login picks the Authorization header when the
+// secret is in the parameters.
+type clientSecretBasic struct {
+	clientID     string
+	clientSecret string
+}
+
+func (c *clientSecretBasic) GetParameters() (url.Values, error) {
+	ret := url.Values{}
+	ret.Add("client_id", c.clientID)
+	ret.Add("client_secret", c.clientSecret)
+	return ret, nil
+}
+
+// clientSecretJWT authenticates the client using a JWT signed with the client secret.
+type clientSecretJWT struct {
+	tenant       string
+	clientID     string
+	clientSecret string
+}
+
+func (c *clientSecretJWT) GetParameters() (url.Values, error) {
+	now := time.Now().UTC()
+	claims := map[string]any{
+		"iss": c.clientID,
+		"sub": c.clientID,
+		"aud": []string{
+			fmt.Sprintf("https://%s/oauth2", c.tenant),
+			fmt.Sprintf("https://%s/oauth2/token", c.tenant),
+		},
+		"exp": now.Add(clientAssertionLifetime).Unix(),
+		"iat": now.Unix(),
+		"jti": uuid.NewString(),
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.HS256,
+		Key:       []byte(c.clientSecret),
+	}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := jws.CompactSerialize()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := url.Values{}
+	ret.Add("client_id", c.clientID)
+	ret.Add("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	ret.Add("client_assertion", token)
+	return ret, nil
+}
+
+// tlsClientAuth authenticates the client using the TLS client certificate. Only
+// the client ID is sent in the request.
+type tlsClientAuth struct {
+	clientID string
+}
+
+func (c *tlsClientAuth) GetParameters() (url.Values, error) {
+	ret := url.Values{}
+	ret.Add("client_id", c.clientID)
+	return ret, nil
+}
+
+// ConvertToClient creates the OAuth client used to get tokens for the tenant.
+func (c *ClientConfig) ConvertToClient(tenant string) (*oidc.Client, error) {
+	client := &oidc.Client{
+		Tenant: tenant,
+		Scopes: c.Scopes,
+	}
+
+	switch c.AuthType {
+	case "", AuthTypeClientSecretPost:
+		client.ClientAuth = &oidc.ClientSecretPost{
+			ClientID:     c.ClientID,
+			ClientSecret: c.ClientSecret,
+		}
+	case AuthTypeClientSecretBasic:
+		if len(c.ClientSecret) == 0 {
+			return nil, errorsx.G11NError("'client_secret' is required for '%s'.", c.AuthType)
+		}
+
+		client.ClientAuth = &clientSecretBasic{
+			clientID:     c.ClientID,
+			clientSecret: c.ClientSecret,
+		}
+	case AuthTypeClientSecretJWT:
+		if len(c.ClientSecret) < minHMACSecretLength {
+			// HS256 requires a key that is at least as long as the hash
+			return nil, errorsx.G11NError("'client_secret' must be at least %d characters for '%s'.", minHMACSecretLength, c.AuthType)
+		}
+
+		client.ClientAuth = &clientSecretJWT{
+			tenant:       tenant,
+			clientID:     c.ClientID,
+			clientSecret: c.ClientSecret,
+		}
+	case AuthTypePrivateKeyJWT:
+		key, err := jwk.Parse(c.PrivateKey, "")
+		if err != nil {
+			return nil, err
+		}
+
+		client.ClientAuth = &oidc.PrivateKeyJWT{
+			Tenant:        tenant,
+			ClientID:      c.ClientID,
+			PrivateKeyJWK: key,
+		}
+	case AuthTypeTLSClientAuth:
+		client.ClientAuth = &tlsClientAuth{
+			clientID: c.ClientID,
+		}
+	default:
+		return nil, errorsx.G11NError("Unsupported auth type '%s'. Use one of: %s", c.AuthType, strings.Join(AuthTypes, ", "))
+	}
+
+	return client, nil
+}
+
+// WithHTTPClient returns a context that makes the OAuth library present the
+// client certificate when tls_client_auth is used.
+func (c *ClientConfig) WithHTTPClient(ctx context.Context) (context.Context, error) {
+	client, err := c.httpClient()
+	if err != nil || client == nil {
+		return ctx, err
+	}
+
+	return context.WithValue(ctx, oauth2.HTTPClient, client), nil
+}
+
+// TokenWithAPIClient gets a token using the client credentials grant.
+func (c *ClientConfig) TokenWithAPIClient(ctx context.Context, tenant string) (*oidc.TokenResponse, error) {
+	client, err := c.ConvertToClient(tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = c.WithHTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.AuthType != AuthTypeClientSecretBasic {
+		return client.TokenWithAPIClient(ctx, c.Parameters)
+	}
+
+	// the SDK always sends the client secret in the request body
+	oauthConfig := &clientcredentials.Config{
+		ClientID:       c.ClientID,
+		ClientSecret:   c.ClientSecret,
+		TokenURL:       fmt.Sprintf("https://%s/oauth2/token", tenant),
+		AuthStyle:      oauth2.AuthStyleInHeader,
+		EndpointParams: c.Parameters,
+		Scopes:         c.Scopes,
+	}
+
+	t, err := oauthConfig.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return oidc.NewTokenResponseWithOAuth2Token(t), nil
+}
+
+// newRequest returns the HTTP client, headers and form parameters used to
+// authenticate the client when calling the OAuth endpoints of the tenant.
+func (c *ClientConfig) newRequest(tenant string) (xhttp.Clientx, http.Header, url.Values, error) {
+	headers := http.Header{
+		"Accept":       []string{"application/json"},
+		"Content-Type": []string{"application/x-www-form-urlencoded"},
+	}
+
+	if c.AuthType == AuthTypeClientSecretBasic {
+		credentials := url.QueryEscape(c.ClientID) + ":" + url.QueryEscape(c.ClientSecret)
+		headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+		return xhttp.NewDefaultClient(), headers, url.Values{}, nil
+	}
+
+	client, err := c.ConvertToClient(tenant)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	params, err := client.ClientAuth.GetParameters()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if httpClient == nil {
+		return xhttp.NewDefaultClient(), headers, params, nil
+	}
+
+	return xhttp.NewClient(httpClient), headers, params, nil
+}
+
+// httpClient returns the HTTP client that presents the client certificate. It
+// returns nil for other auth types.
+func (c *ClientConfig) httpClient() (*http.Client, error) {
+	if c.AuthType != AuthTypeTLSClientAuth {
+		return nil, nil
+	}
+
+	key, err := jwk.Parse(c.PrivateKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(key.Certificates) == 0 {
+		return nil, errorsx.G11NError("A client certificate is required for '%s'.", c.AuthType)
+	}
+
+	cert := tls.Certificate{
+		PrivateKey: key.Key,
+		Leaf:       key.Certificates[0],
+	}
+
+	for _, c := range key.Certificates {
+		cert.Certificate = append(cert.Certificate, c.Raw)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	return xhttp.NewHTTPClient(transport), nil
+}
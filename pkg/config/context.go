@@ -0,0 +1,316 @@
+package config
+
+import (
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"github.com/spf13/cobra"
+)
+
+// ContextConfig pairs a tenant with the credential used to access it. This
+// allows multiple identities to be configured for the same tenant.
+type ContextConfig struct {
+	Name   string `yaml:"name" json:"name"`
+	Tenant string `yaml:"tenant" json:"tenant"`
+
+	// Auth is the name of the auth entry that holds the credential.
+	Auth string `yaml:"auth" json:"auth"`
+
+	// Defaults contains default flag values, such as 'output', that are
+	// applied to commands run in this context unless explicitly set.
+	Defaults map[string]string `yaml:"defaults,omitempty" json:"defaults,omitempty"`
+}
+
+func (o *CLIConfig) AddContext(config *ContextConfig) {
+	// check if it already exists and replace if so
+	for _, c := range o.Contexts {
+		if c.Name == config.Name {
+			c.Merge(config)
+			return
+		}
+	}
+
+	// add it to the context list
+	o.Contexts = append(o.Contexts, config)
+}
+
+func (o *CLIConfig) GetContext(name string) *ContextConfig {
+	for _, c := range o.Contexts {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// GetCurrentContext returns the context selected using the '--context' flag or
+// the current context. It returns nil if neither is configured.
+func (o *CLIConfig) GetCurrentContext() *ContextConfig {
+	name := o.contextName()
+	if len(name) == 0 {
+		return nil
+	}
+
+	return o.GetContext(name)
+}
+
+func (o *CLIConfig) SetCurrentContext(name string) {
+	o.CurrentContext = name
+	if c := o.GetContext(name); c != nil {
+		o.CurrentTenant = c.Tenant
+	}
+}
+
+// ApplyDefaults sets the flags on the command using the defaults configured
+// on the current context. Flags set explicitly are not overridden.
+func (o *CLIConfig) ApplyDefaults(cmd *cobra.Command) error {
+	c := o.GetCurrentContext()
+	if c == nil {
+		return nil
+	}
+
+	for name, value := range c.Defaults {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil || flag.Changed {
+			continue
+		}
+
+		if err := flag.Value.Set(value); err != nil {
+			return errorsx.G11NError("Invalid default '%s' in context '%s'; err=%v", name, c.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// CurrentContextName returns the name of the context in use. Configurations
+// without contexts use the name of the auth entry for the current tenant.
+func (o *CLIConfig) CurrentContextName() string {
+	if name := o.contextName(); len(name) > 0 {
+		return name
+	}
+
+	for _, c := range o.Auth {
+		if c.Tenant == o.CurrentTenant {
+			return c.Name
+		}
+	}
+
+	return ""
+}
+
+func (o *CLIConfig) contextName() string {
+	if len(o.SelectedContext) > 0 {
+		return o.SelectedContext
+	}
+
+	return o.CurrentContext
+}
+
+func (o *CLIConfig) getContextAuth(name string) (*AuthConfig, error) {
+	c := o.GetContext(name)
+	if c == nil {
+		// auth entries can be selected by name without defining a context
+		if auth := o.GetAuth(name); auth != nil {
+			return auth, nil
+		}
+
+		return nil, errorsx.G11NError("Context '%s' not found.", name)
+	}
+
+	authName := c.AuthName()
+	auth := o.GetAuth(authName)
+	if auth == nil {
+		return nil, errorsx.G11NError("No login session available for context '%s'. Use:\n  verifyctl login -h", name)
+	}
+
+	if len(c.Tenant) > 0 && c.Tenant != auth.Tenant {
+		return nil, errorsx.G11NError("Context '%s' refers to tenant '%s', but the auth entry '%s' belongs to tenant '%s'.", name, c.Tenant, authName, auth.Tenant)
+	}
+
+	return auth, nil
+}
+
+// AuthName returns the name of the auth entry. Contexts without one use the
+// auth entry named after the tenant.
+func (o *ContextConfig) AuthName() string {
+	if len(o.Auth) == 0 {
+		return o.Tenant
+	}
+
+	return o.Auth
+}
+
+func (o *ContextConfig) Merge(c *ContextConfig) {
+	o.Name = c.Name
+	o.Tenant = c.Tenant
+	o.Auth = c.Auth
+	if c.Defaults != nil {
+		o.Defaults = c.Defaults
+	}
+}
+
+// UseContext makes the named context current. The name may refer to a context,
+// an auth entry or a tenant with a single auth entry.
+func (o *CLIConfig) UseContext(name string) error {
+	if c := o.GetContext(name); c != nil {
+		o.SetCurrentContext(name)
+		return nil
+	}
+
+	auth := o.GetAuth(name)
+	if auth == nil {
+		auths := o.getTenantAuths(name)
+		if len(auths) == 0 {
+			return errorsx.G11NError("Context '%s' not found.", name)
+		}
+
+		if len(auths) > 1 {
+			return errorsx.G11NError("Multiple sessions are available for tenant '%s'. Use the context name instead.", name)
+		}
+
+		auth = auths[0]
+	}
+
+	o.AddContext(&ContextConfig{
+		Name:   auth.Name,
+		Tenant: auth.Tenant,
+		Auth:   auth.Name,
+	})
+
+	o.SetCurrentContext(auth.Name)
+	return nil
+}
+
+// DeleteContext removes the named context and the auth entry it uses. If the name
+// refers to a tenant, all contexts and auth entries for the tenant are removed.
+func (o *CLIConfig) DeleteContext(name string) error {
+	var authNames []string
+	if c := o.GetContext(name); c != nil {
+		authNames = append(authNames, c.AuthName())
+		o.Contexts = removeContexts(o.Contexts, func(c *ContextConfig) bool { return c.Name == name })
+	} else if auth := o.GetAuth(name); auth != nil {
+		authNames = append(authNames, auth.Name)
+		o.Contexts = removeContexts(o.Contexts, func(c *ContextConfig) bool { return c.AuthName() == name })
+	} else if auths := o.getTenantAuths(name); len(auths) > 0 {
+		for _, auth := range auths {
+			authNames = append(authNames, auth.Name)
+		}
+
+		o.Contexts = removeContexts(o.Contexts, func(c *ContextConfig) bool { return c.Tenant == name })
+	} else {
+		return errorsx.G11NError("Context '%s' not found.", name)
+	}
+
+	for _, authName := range authNames {
+		if o.isAuthReferenced(authName) {
+			// still used by another context
+			continue
+		}
+
+		o.removeAuth(authName)
+	}
+
+	o.resetCurrent()
+	return nil
+}
+
+// RemoveAuth removes the auth entry and the contexts that use it.
+func (o *CLIConfig) RemoveAuth(name string) {
+	o.Contexts = removeContexts(o.Contexts, func(c *ContextConfig) bool { return c.AuthName() == name })
+	o.removeAuth(name)
+	o.resetCurrent()
+}
+
+func (o *CLIConfig) removeAuth(name string) {
+	if auth := o.GetAuth(name); auth != nil && len(auth.CredentialRef) > 0 {
+		o.removedRefs = append(o.removedRefs, auth.CredentialRef)
+	}
+
+	o.Auth = removeAuths(o.Auth, func(c *AuthConfig) bool { return c.Name == name })
+}
+
+// resetCurrent clears the current context and tenant if they no longer exist.
+func (o *CLIConfig) resetCurrent() {
+	if len(o.CurrentContext) > 0 && o.GetContext(o.CurrentContext) == nil && o.GetAuth(o.CurrentContext) == nil {
+		o.CurrentContext = ""
+	}
+
+	if len(o.CurrentContext) == 0 && len(o.getTenantAuths(o.CurrentTenant)) == 0 {
+		o.CurrentTenant = ""
+	}
+}
+
+// RenameContext renames the context and the auth entry of the same name.
+func (o *CLIConfig) RenameContext(oldName string, newName string) error {
+	if o.GetContext(newName) != nil || o.GetAuth(newName) != nil {
+		return errorsx.G11NError("Context '%s' already exists.", newName)
+	}
+
+	c := o.GetContext(oldName)
+	auth := o.GetAuth(oldName)
+	if c == nil && auth == nil {
+		return errorsx.G11NError("Context '%s' not found.", oldName)
+	}
+
+	if c != nil {
+		c.Name = newName
+	}
+
+	if auth != nil {
+		auth.Name = newName
+		for _, c := range o.Contexts {
+			if c.AuthName() == oldName {
+				c.Auth = newName
+			}
+		}
+	}
+
+	if o.CurrentContext == oldName {
+		o.CurrentContext = newName
+	}
+
+	return nil
+}
+
+func (o *CLIConfig) getTenantAuths(tenant string) []*AuthConfig {
+	auths := []*AuthConfig{}
+	for _, c := range o.Auth {
+		if c.Tenant == tenant {
+			auths = append(auths, c)
+		}
+	}
+
+	return auths
+}
+
+func (o *CLIConfig) isAuthReferenced(name string) bool {
+	for _, c := range o.Contexts {
+		if c.AuthName() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeContexts(contexts []*ContextConfig, match func(c *ContextConfig) bool) []*ContextConfig {
+	ret := []*ContextConfig{}
+	for _, c := range contexts {
+		if !match(c) {
+			ret = append(ret, c)
+		}
+	}
+
+	return ret
+}
+
+func removeAuths(auths []*AuthConfig, match func(c *AuthConfig) bool) []*AuthConfig {
+	ret := []*AuthConfig{}
+	for _, c := range auths {
+		if !match(c) {
+			ret = append(ret, c)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,262 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	// CredentialStorePlain keeps the tokens in the config file. This is the
+	// behavior of older versions and remains the default. Client secrets and
+	// private keys are not kept.
+	CredentialStorePlain = "plain"
+
+	// CredentialStoreKeyring keeps the credentials in the OS keyring.
+	CredentialStoreKeyring = "keyring"
+
+	// CredentialStoreFile keeps the credentials in a file encrypted using a passphrase.
+	CredentialStoreFile = "file"
+
+	// credentialStoreEnv overrides the credential store set in the config file.
+	credentialStoreEnv = "VERIFY_CREDENTIAL_STORE"
+)
+
+// Credential contains the secrets of an auth entry that are kept in the
+// credential store instead of the config file.
+type Credential struct {
+	Token        string `json:"token,omitempty"`
+	RefreshToken string `json:"refreshToken,omitempty"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	PrivateKey   string `json:"key,omitempty"`
+}
+
+// CredentialStore saves and retrieves credentials using the reference held
+// by the auth entry.
+type CredentialStore interface {
+	Get(ref string) (*Credential, error)
+	Set(ref string, credential *Credential) error
+	Delete(ref string) error
+}
+
+// CredentialStoreNames lists the supported credential stores.
+var CredentialStoreNames = []string{
+	CredentialStorePlain,
+	CredentialStoreKeyring,
+	CredentialStoreFile,
+}
+
+// NewCredentialStore returns the credential store with the given name. It returns
+// nil for the plain store because the credentials remain in the config file.
+func NewCredentialStore(name string) (CredentialStore, error) {
+	switch name {
+	case "", CredentialStorePlain:
+		return nil, nil
+	case CredentialStoreKeyring:
+		return newKeyringStore()
+	case CredentialStoreFile:
+		return newFileStore()
+	default:
+		return nil, errorsx.G11NError("Unsupported credential store '%s'. Use one of: %s", name, strings.Join(CredentialStoreNames, ", "))
+	}
+}
+
+// CredentialStoreName returns the name of the credential store in use.
+func (o *CLIConfig) CredentialStoreName() string {
+	if name := os.Getenv(credentialStoreEnv); len(name) > 0 {
+		return name
+	}
+
+	if len(o.CredentialStore) == 0 {
+		return CredentialStorePlain
+	}
+
+	return o.CredentialStore
+}
+
+// SetCredentialStore moves the credentials of all auth entries to the named store.
+// The credentials are written when the config file is persisted.
+//
+// It fails while the store is overridden using the environment variable, because the
+// credentials would be read from and written to the overriding store instead of the
+// ones in the config file.
+func (o *CLIConfig) SetCredentialStore(name string) error {
+	if len(os.Getenv(credentialStoreEnv)) > 0 {
+		return errorsx.G11NError("The credential store cannot be changed while %s is set. Unset it and try again.", credentialStoreEnv)
+	}
+
+	if _, err := NewCredentialStore(name); err != nil {
+		return err
+	}
+
+	// read everything from the current store before switching
+	if err := o.LoadCredentials(); err != nil {
+		return err
+	}
+
+	current, err := NewCredentialStore(o.CredentialStoreName())
+	if err != nil {
+		return err
+	}
+
+	for _, auth := range o.Auth {
+		if len(auth.CredentialRef) == 0 {
+			continue
+		}
+
+		if current != nil {
+			// the entry may have never been written
+			_ = current.Delete(auth.CredentialRef)
+		}
+
+		auth.CredentialRef = ""
+	}
+
+	if name == CredentialStorePlain {
+		name = ""
+	}
+
+	o.CredentialStore = name
+	return nil
+}
+
+// LoadCredentials reads the credentials of all auth entries from the credential store.
+func (o *CLIConfig) LoadCredentials() error {
+	for _, auth := range o.Auth {
+		if err := o.loadCredential(auth); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *CLIConfig) loadCredential(auth *AuthConfig) error {
+	if auth.hasCredential() {
+		return nil
+	}
+
+	store, err := NewCredentialStore(o.CredentialStoreName())
+	if err != nil {
+		return err
+	}
+
+	if store == nil {
+		return errorsx.G11NError("The credentials for '%s' are not in the config file. Set the credential store used to save them.", auth.Name)
+	}
+
+	credential, err := store.Get(auth.CredentialRef)
+	if err != nil {
+		return errorsx.G11NError("Unable to read the credentials for '%s'; err=%v", auth.Name, err)
+	}
+
+	auth.setCredential(credential)
+	return nil
+}
+
+// saveCredentials writes the credentials to the store and returns a copy of the
+// config without secrets. For the plain store, the copy holds the tokens, but not
+// the client secrets and private keys. See withoutClientSecrets.
+func (o *CLIConfig) saveCredentials() (*CLIConfig, error) {
+	store, err := NewCredentialStore(o.CredentialStoreName())
+	if err != nil {
+		return nil, err
+	}
+
+	if store == nil {
+		return o.withoutClientSecrets(), nil
+	}
+
+	for _, ref := range o.removedRefs {
+		// the entry may have never been written
+		_ = store.Delete(ref)
+	}
+
+	o.removedRefs = nil
+
+	c := *o
+	c.Auth = make([]*AuthConfig, 0, len(o.Auth))
+	for _, auth := range o.Auth {
+		if auth.hasCredential() {
+			if len(auth.CredentialRef) == 0 {
+				auth.CredentialRef = newCredentialRef()
+			}
+
+			if err := store.Set(auth.CredentialRef, auth.credential()); err != nil {
+				return nil, errorsx.G11NError("Unable to save the credentials for '%s'; err=%v", auth.Name, err)
+			}
+
+			auth.credentialLoaded = true
+		}
+
+		a := *auth
+		if a.Client != nil {
+			client := *a.Client
+			a.Client = &client
+		}
+
+		a.setCredential(&Credential{})
+		c.Auth = append(c.Auth, &a)
+	}
+
+	return &c, nil
+}
+
+// withoutClientSecrets returns a copy of the config without the client secrets and
+// private keys. They are never saved in the clear, because unlike the tokens they
+// do not expire. The sessions of API clients cannot be renewed without them, so
+// the user has to login again once the token expires.
+func (o *CLIConfig) withoutClientSecrets() *CLIConfig {
+	c := *o
+	c.Auth = make([]*AuthConfig, 0, len(o.Auth))
+	for _, auth := range o.Auth {
+		a := *auth
+		if a.Client != nil {
+			client := *a.Client
+			client.ClientSecret = ""
+			client.PrivateKey = ""
+			a.Client = &client
+		}
+
+		c.Auth = append(c.Auth, &a)
+	}
+
+	return &c
+}
+
+func newCredentialRef() string {
+	return "verifyctl-" + uuid.NewString()
+}
+
+// hasCredential indicates if the secrets are held in memory. This is the case
+// for new entries and for entries loaded from the store or the config file.
+func (o *AuthConfig) hasCredential() bool {
+	return len(o.CredentialRef) == 0 || o.credentialLoaded
+}
+
+func (o *AuthConfig) credential() *Credential {
+	credential := &Credential{
+		Token:        o.Token,
+		RefreshToken: o.RefreshToken,
+	}
+
+	if o.Client != nil {
+		credential.ClientSecret = o.Client.ClientSecret
+		credential.PrivateKey = o.Client.PrivateKey
+	}
+
+	return credential
+}
+
+func (o *AuthConfig) setCredential(credential *Credential) {
+	o.Token = credential.Token
+	o.RefreshToken = credential.RefreshToken
+	if o.Client != nil {
+		o.Client.ClientSecret = credential.ClientSecret
+		o.Client.PrivateKey = credential.PrivateKey
+	}
+
+	o.credentialLoaded = true
+}
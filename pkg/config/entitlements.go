@@ -0,0 +1,113 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+// Entitlement IDs configured on the application or API client to grant access
+// to the APIs used by the commands.
+const (
+	EntitlementManageAccessPolicies  = "manageAccessPolicies"
+	EntitlementReadAccessPolicies    = "readAccessPolicies"
+	EntitlementManageAPIClients      = "manageAPIClients"
+	EntitlementReadAPIClients        = "readAPIClients"
+	EntitlementManageAppAccessAdmin  = "manageAppAccessAdmin"
+	EntitlementManageAttributes      = "manageAttributes"
+	EntitlementReadAttributes        = "readAttributes"
+	EntitlementManageCerts           = "manageCerts"
+	EntitlementReadCerts             = "readCerts"
+	EntitlementManageExternalAgents  = "manageExternalAgents"
+	EntitlementReadExternalAgents    = "readExternalAgents"
+	EntitlementManageIdentitySources = "manageIdentitySources"
+	EntitlementReadIdentitySources   = "readIdentitySources"
+	EntitlementManagePwdPolicy       = "managePwdPolicy"
+	EntitlementReadPwdPolicy         = "readPwdPolicy"
+	EntitlementManageTemplates       = "manageTemplates"
+	EntitlementReadTemplates         = "readTemplates"
+	EntitlementReadTraceLogs         = "readTraceLogs"
+	EntitlementManageUserGroups      = "manageUserGroups"
+	EntitlementManageAllUserGroups   = "manageAllUserGroups"
+	EntitlementReadUserGroups        = "readUserGroups"
+)
+
+// entitlementNames holds the names of the entitlements as shown in the admin console.
+var entitlementNames = map[string]string{
+	EntitlementManageAccessPolicies:  "Manage access policies",
+	EntitlementReadAccessPolicies:    "Read access policies",
+	EntitlementManageAPIClients:      "Manage API clients",
+	EntitlementReadAPIClients:        "Read API clients",
+	EntitlementManageAppAccessAdmin:  "Manage application lifecycle",
+	EntitlementManageAttributes:      "Manage attribute sources",
+	EntitlementReadAttributes:        "Read attribute sources",
+	EntitlementManageCerts:           "Manage certificates",
+	EntitlementReadCerts:             "Read certificates",
+	EntitlementManageExternalAgents:  "Manage external agents",
+	EntitlementReadExternalAgents:    "Read external agents",
+	EntitlementManageIdentitySources: "Manage identity providers",
+	EntitlementReadIdentitySources:   "Read identity providers",
+	EntitlementManagePwdPolicy:       "Manage password policy",
+	EntitlementReadPwdPolicy:         "Read password policy",
+	EntitlementManageTemplates:       "Manage templates and themes",
+	EntitlementReadTemplates:         "Read templates and themes",
+	EntitlementReadTraceLogs:         "Read trace logs",
+	EntitlementManageUserGroups:      "Manage users and groups",
+	EntitlementManageAllUserGroups:   "Synchronize users and groups",
+	EntitlementReadUserGroups:        "Read users and groups",
+}
+
+// Entitlements lists the entitlements that grant access to an operation. Any one
+// of them is sufficient.
+type Entitlements []string
+
+// String lists the entitlements with their names, one per line.
+func (e Entitlements) String() string {
+	lines := make([]string, 0, len(e))
+	for _, id := range e {
+		if name, ok := entitlementNames[id]; ok {
+			lines = append(lines, fmt.Sprintf("  %s (%s)", id, name))
+		} else {
+			lines = append(lines, "  "+id)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// CheckEntitlements verifies that the token is granted at least one of the
+// entitlements before the tenant is called. The entitlements and scopes are read
+// from the claims of a JWT and from the scopes saved when the token was issued.
+//
+// The check is skipped if neither are available, for example when a credential
+// plugin returns an opaque token. The tenant still enforces the entitlements in
+// that case.
+func (o *AuthConfig) CheckEntitlements(ctx context.Context, entitlements Entitlements) error {
+	if len(entitlements) == 0 {
+		return nil
+	}
+
+	granted := slices.Clone(o.GrantedScopes)
+	if info := o.DecodeToken(); info != nil {
+		granted = append(granted, info.Entitlements...)
+		granted = append(granted, info.Scopes...)
+	}
+
+	if len(granted) == 0 {
+		vc := contextx.GetVerifyContext(ctx)
+		vc.Logger.Debugf("the entitlements of the token are not known and are not checked; name=%s", o.Name)
+		return nil
+	}
+
+	for _, id := range entitlements {
+		if slices.Contains(granted, id) {
+			return nil
+		}
+	}
+
+	return errorsx.G11NError("The token for '%s' is missing the entitlements required for this command. Grant any of the following to the application or API client and login again:\n%s", o.Name, entitlements.String())
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"os"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+// Environment variables used to authenticate without a config file, such as
+// in CI pipelines.
+const (
+	tenantEnv       = "VERIFY_TENANT"
+	tokenEnv        = "VERIFY_TOKEN"
+	clientIDEnv     = "VERIFY_CLIENT_ID"
+	clientSecretEnv = "VERIFY_CLIENT_SECRET"
+	privateKeyEnv   = "VERIFY_PRIVATE_KEY"
+)
+
+// getOverrideAuth returns the auth entry built from the '--tenant' and '--token'
+// flags or the environment variables. It returns nil if none are set.
+//
+// The entry is held in memory and never persisted. If client credentials are
+// provided instead of a token, the token is obtained when the entry is used.
+func (o *CLIConfig) getOverrideAuth() (*AuthConfig, error) {
+	tenant := firstNonEmpty(o.TenantOverride, os.Getenv(tenantEnv))
+	token := firstNonEmpty(o.TokenOverride, os.Getenv(tokenEnv))
+	clientID := os.Getenv(clientIDEnv)
+
+	if len(token) == 0 && len(clientID) == 0 {
+		if len(tenant) == 0 || len(o.SelectedContext) > 0 {
+			// the context takes precedence over the tenant
+			return nil, nil
+		}
+
+		// use the stored session for the tenant
+		auths := o.getTenantAuths(tenant)
+		if len(auths) == 0 {
+			return nil, errorsx.G11NError("No login session available for tenant '%s'. Use:\n  verifyctl login -h", tenant)
+		}
+
+		if len(auths) > 1 {
+			return nil, errorsx.G11NError("Multiple sessions are available for tenant '%s'. Use '--context' instead.", tenant)
+		}
+
+		return auths[0], nil
+	}
+
+	if len(tenant) == 0 {
+		return nil, errorsx.G11NError("The tenant is required. Use the '--tenant' flag or the '%s' environment variable.", tenantEnv)
+	}
+
+	auth := &AuthConfig{
+		Name:             tenant,
+		Tenant:           tenant,
+		Token:            token,
+		credentialLoaded: true,
+		ephemeral:        true,
+	}
+
+	if len(token) > 0 {
+		return auth, nil
+	}
+
+	auth.Client = &ClientConfig{
+		ClientID:     clientID,
+		ClientSecret: os.Getenv(clientSecretEnv),
+		PrivateKey:   os.Getenv(privateKeyEnv),
+	}
+
+	if len(auth.Client.PrivateKey) > 0 {
+		auth.Client.AuthType = "private_key_jwt"
+	}
+
+	return auth, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if len(v) > 0 {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,275 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+)
+
+const (
+	execCacheDir = "cache"
+
+	// execTenantEnv tells the credential plugin which tenant the credentials are for.
+	execTenantEnv = "VERIFY_EXEC_TENANT"
+)
+
+// ExecConfig describes an external command, such as a helper for a secret store,
+// that prints the credentials for the session. The secrets are never saved in the
+// config file. The token is cached in the credential store until it expires. It is
+// not cached when the plain store is used, so that it is never saved in the clear.
+type ExecConfig struct {
+	Command string            `yaml:"command" json:"command"`
+	Args    []string          `yaml:"args,omitempty" json:"args,omitempty"`
+	Env     map[string]string `yaml:"env,omitempty" json:"env,omitempty"`
+}
+
+// ExecCredential is the JSON printed by the command. It either holds a token or the
+// client credentials used to get a token with the client credentials grant.
+type ExecCredential struct {
+	Token string `json:"token,omitempty"`
+
+	// ExpiresAt is the expiry of the token in seconds since the epoch. If it is not
+	// set, the expiry is read from the token if it is a JWT.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+
+	ClientID     string `json:"clientId,omitempty"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	AuthType     string `json:"authType,omitempty"`
+	PrivateKey   string `json:"key,omitempty"`
+}
+
+// execCache is the entry kept on disk for the token cached for a credential
+// plugin. The token itself is saved in the credential store using the reference.
+type execCache struct {
+	CredentialRef string   `json:"credentialRef"`
+	ExpiresAt     int64    `json:"expiresAt"`
+	GrantedScopes []string `json:"grantedScopes,omitempty"`
+}
+
+// loadExecToken sets the token cached for the credential plugin, or runs the
+// plugin if there is none or it is about to expire. The store is nil for the
+// plain store, in which case the token is kept in memory only.
+func (o *AuthConfig) loadExecToken(ctx context.Context, store CredentialStore) error {
+	vc := contextx.GetVerifyContext(ctx)
+	if store != nil {
+		if err := o.readExecCache(store); err != nil {
+			vc.Logger.Warnf("unable to read the cached token; name=%s, err=%v", o.Name, err)
+		}
+	}
+
+	if len(o.Token) > 0 && !o.isExpiring() {
+		return nil
+	}
+
+	if err := o.runExec(ctx); err != nil {
+		return err
+	}
+
+	if store != nil {
+		if err := o.writeExecCache(store); err != nil {
+			vc.Logger.Warnf("unable to cache the token; name=%s, err=%v", o.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// runExec runs the credential plugin and sets the token it returns. If the plugin
+// returns client credentials, a token is requested from the tenant.
+func (o *AuthConfig) runExec(ctx context.Context) error {
+	vc := contextx.GetVerifyContext(ctx)
+	if len(o.Exec.Command) == 0 {
+		return errorsx.G11NError("'command' is required for the credential plugin of '%s'.", o.Name)
+	}
+
+	cmd := exec.CommandContext(ctx, o.Exec.Command, o.Exec.Args...)
+	cmd.Env = append(os.Environ(), execTenantEnv+"="+o.Tenant)
+	for name, value := range o.Exec.Env {
+		cmd.Env = append(cmd.Env, name+"="+value)
+	}
+
+	// the plugin may prompt the user
+	stdout := &bytes.Buffer{}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		vc.Logger.Errorf("credential plugin failed; command=%s, err=%v", o.Exec.Command, err)
+		return errorsx.G11NError("The credential plugin '%s' failed; err=%v", o.Exec.Command, err)
+	}
+
+	credential := &ExecCredential{}
+	if err := json.Unmarshal(stdout.Bytes(), credential); err != nil {
+		return errorsx.G11NError("The credential plugin '%s' returned an invalid response; err=%v", o.Exec.Command, err)
+	}
+
+	if len(credential.Token) > 0 {
+		o.Token = credential.Token
+		o.ExpiresAt = credential.ExpiresAt
+		o.GrantedScopes = nil
+		if o.ExpiresAt == 0 {
+			if info := o.DecodeToken(); info != nil {
+				o.ExpiresAt = info.ExpiresAt
+			}
+		}
+
+		return nil
+	}
+
+	if len(credential.ClientID) == 0 {
+		return errorsx.G11NError("The credential plugin '%s' did not return a token or client credentials.", o.Exec.Command)
+	}
+
+	client := &ClientConfig{
+		ClientID:     credential.ClientID,
+		ClientSecret: credential.ClientSecret,
+		AuthType:     credential.AuthType,
+		PrivateKey:   credential.PrivateKey,
+	}
+
+	tokenResponse, err := client.TokenWithAPIClient(ctx, o.Tenant)
+	if err != nil {
+		return err
+	}
+
+	o.SetToken(ctx, tokenResponse)
+	return nil
+}
+
+// readExecCache sets the cached token, if any.
+func (o *AuthConfig) readExecCache(store CredentialStore) error {
+	cache, err := o.readExecCacheEntry()
+	if err != nil || cache == nil {
+		return err
+	}
+
+	credential, err := store.Get(cache.CredentialRef)
+	if err != nil {
+		return err
+	}
+
+	o.Token = credential.Token
+	o.ExpiresAt = cache.ExpiresAt
+	o.GrantedScopes = cache.GrantedScopes
+	return nil
+}
+
+func (o *AuthConfig) writeExecCache(store CredentialStore) error {
+	// a token without a known expiry is not cached
+	if o.ExpiresAt == 0 {
+		return nil
+	}
+
+	cache, err := o.readExecCacheEntry()
+	if err != nil || cache == nil {
+		cache = &execCache{
+			CredentialRef: newCredentialRef(),
+		}
+	}
+
+	if err := store.Set(cache.CredentialRef, &Credential{Token: o.Token}); err != nil {
+		return err
+	}
+
+	path, err := o.execCachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	cache.ExpiresAt = o.ExpiresAt
+	cache.GrantedScopes = o.GrantedScopes
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	return writeSecureFile(path, data)
+}
+
+// removeExecCache removes the cached token, if any.
+func (o *AuthConfig) removeExecCache(store CredentialStore) error {
+	cache, err := o.readExecCacheEntry()
+	if err != nil {
+		return err
+	}
+
+	if cache == nil {
+		return nil
+	}
+
+	if store != nil {
+		// the token may have never been written
+		_ = store.Delete(cache.CredentialRef)
+	}
+
+	path, err := o.execCachePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// readExecCacheEntry returns the entry of the cached token, or nil if there is none.
+func (o *AuthConfig) readExecCacheEntry() (*execCache, error) {
+	path, err := o.execCachePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	cache := &execCache{}
+	if err := json.Unmarshal(data, cache); err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+// execCachePath returns the path of the entry of the cached token. The name is
+// derived from the entry and the command, including its environment, so that a
+// change to the plugin invalidates the cache.
+func (o *AuthConfig) execCachePath() (string, error) {
+	configDir, err := cmdutil.GetDir()
+	if err != nil {
+		return "", err
+	}
+
+	env := make([]string, 0, len(o.Exec.Env))
+	for name, value := range o.Exec.Env {
+		env = append(env, name+"="+value)
+	}
+
+	sort.Strings(env)
+	key := []string{o.Name, o.Tenant, o.Exec.Command}
+	key = append(key, o.Exec.Args...)
+	key = append(key, env...)
+	sum := sha256.Sum256([]byte(strings.Join(key, "\x00")))
+	return filepath.Join(configDir, execCacheDir, "exec-"+hex.EncodeToString(sum[:16])+".json"), nil
+}
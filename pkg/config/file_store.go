@@ -0,0 +1,188 @@
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	credentialsFileName = "credentials"
+
+	// passphraseEnv holds the passphrase used to encrypt the credentials file.
+	passphraseEnv = "VERIFY_CREDENTIAL_PASSPHRASE"
+
+	// scrypt parameters recommended for interactive logins
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	keyLength    = 32
+	saltLength   = 16
+	securePerm   = 0o600
+	fileStoreAlg = "scrypt+A256GCM"
+)
+
+// fileStore saves credentials in a file in the config directory. The file is
+// encrypted using AES-256-GCM with a key derived from the passphrase.
+type fileStore struct {
+	path       string
+	passphrase string
+}
+
+// encryptedFile is the format of the credentials file.
+type encryptedFile struct {
+	Algorithm string `json:"alg"`
+	Salt      []byte `json:"salt"`
+	Nonce     []byte `json:"nonce"`
+	Data      []byte `json:"data"`
+}
+
+func newFileStore() (CredentialStore, error) {
+	passphrase := os.Getenv(passphraseEnv)
+	if len(passphrase) == 0 {
+		return nil, errorsx.G11NError("Set the passphrase for the credentials file using the '%s' environment variable.", passphraseEnv)
+	}
+
+	configDir, err := cmdutil.GetDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileStore{
+		path:       filepath.Join(configDir, credentialsFileName),
+		passphrase: passphrase,
+	}, nil
+}
+
+func (s *fileStore) Get(ref string) (*Credential, error) {
+	credentials, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	credential, ok := credentials[ref]
+	if !ok {
+		return nil, errorsx.G11NError("Credential '%s' not found.", ref)
+	}
+
+	return credential, nil
+}
+
+func (s *fileStore) Set(ref string, credential *Credential) error {
+	credentials, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	credentials[ref] = credential
+	return s.write(credentials)
+}
+
+func (s *fileStore) Delete(ref string) error {
+	credentials, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := credentials[ref]; !ok {
+		return nil
+	}
+
+	delete(credentials, ref)
+	return s.write(credentials)
+}
+
+func (s *fileStore) read() (map[string]*Credential, error) {
+	credentials := map[string]*Credential{}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return credentials, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	file := &encryptedFile{}
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, err
+	}
+
+	if file.Algorithm != fileStoreAlg {
+		return nil, errorsx.G11NError("Unsupported credentials file algorithm '%s'.", file.Algorithm)
+	}
+
+	aead, err := s.cipher(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, file.Nonce, file.Data, nil)
+	if err != nil {
+		return nil, errorsx.G11NError("Unable to decrypt the credentials file. Check the passphrase.")
+	}
+
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return nil, err
+	}
+
+	return credentials, nil
+}
+
+func (s *fileStore) write(credentials map[string]*Credential) error {
+	plaintext, err := json.Marshal(credentials)
+	if err != nil {
+		return err
+	}
+
+	file := &encryptedFile{
+		Algorithm: fileStoreAlg,
+		Salt:      make([]byte, saltLength),
+	}
+
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+
+	aead, err := s.cipher(file.Salt)
+	if err != nil {
+		return err
+	}
+
+	file.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+
+	file.Data = aead.Seal(nil, file.Nonce, plaintext, nil)
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	if _, err := cmdutil.CreateOrGetDir(); err != nil {
+		return err
+	}
+
+	return writeSecureFile(s.path, data)
+}
+
+func (s *fileStore) cipher(salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(s.passphrase), salt, scryptN, scryptR, scryptP, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
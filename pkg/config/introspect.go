@@ -0,0 +1,171 @@
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"github.com/ibm-verify/verifyctl/pkg/module"
+	xhttp "github.com/ibm-verify/verifyctl/pkg/util/http"
+)
+
+// Sources of the token details
+const (
+	TokenInfoSourceLocal         = "local"
+	TokenInfoSourceIntrospection = "introspection"
+)
+
+// TokenInfo describes the identity and permissions carried by a token.
+type TokenInfo struct {
+	Active       bool     `yaml:"active" json:"active"`
+	Subject      string   `yaml:"subject,omitempty" json:"subject,omitempty"`
+	ClientID     string   `yaml:"clientId,omitempty" json:"clientId,omitempty"`
+	GrantType    string   `yaml:"grantType,omitempty" json:"grantType,omitempty"`
+	IssuedAt     int64    `yaml:"issuedAt,omitempty" json:"issuedAt,omitempty"`
+	ExpiresAt    int64    `yaml:"expiresAt,omitempty" json:"expiresAt,omitempty"`
+	Scopes       []string `yaml:"scopes,omitempty" json:"scopes,omitempty"`
+	Entitlements []string `yaml:"entitlements,omitempty" json:"entitlements,omitempty"`
+
+	// Source indicates if the details were decoded locally or obtained
+	// from the introspection endpoint.
+	Source string `yaml:"source" json:"source"`
+}
+
+// DecodeToken reads the claims of a JWT access token without calling the tenant.
+// The signature is not validated. It returns nil if the token is not a JWT.
+func (o *AuthConfig) DecodeToken() *TokenInfo {
+	parts := strings.Split(o.Token, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+
+	claims := map[string]any{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil
+	}
+
+	info := newTokenInfo(claims, TokenInfoSourceLocal)
+	info.Active = info.ExpiresAt == 0 || time.Now().Unix() < info.ExpiresAt
+	return info
+}
+
+// Introspect gets the token details from the introspection endpoint of the tenant
+// using the client details saved when the session was created.
+func (o *AuthConfig) Introspect(ctx context.Context) (*TokenInfo, error) {
+	vc := contextx.GetVerifyContext(ctx)
+	client, headers, params, err := o.newClientRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	params.Set("token", o.Token)
+	params.Set("token_type_hint", "access_token")
+
+	u, _ := url.Parse(fmt.Sprintf("https://%s/oauth2/introspect", o.Tenant))
+	response, err := client.Post(ctx, u, headers, []byte(params.Encode()))
+	if err != nil {
+		vc.Logger.Errorf("unable to introspect the token; err=%s", err.Error())
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		if err := module.HandleCommonErrorsX(ctx, response, "unable to introspect the token"); err != nil {
+			vc.Logger.Errorf("unable to introspect the token; err=%s", err.Error())
+			return nil, err
+		}
+
+		return nil, errorsx.G11NError("unable to introspect the token")
+	}
+
+	claims := map[string]any{}
+	if err := json.Unmarshal(response.Body, &claims); err != nil {
+		return nil, errorsx.G11NError("unable to introspect the token")
+	}
+
+	info := newTokenInfo(claims, TokenInfoSourceIntrospection)
+	info.Active, _ = claims["active"].(bool)
+	return info, nil
+}
+
+// newClientRequest returns the HTTP client, headers and parameters used to call
+// the OAuth endpoints of the tenant with the client details saved with the session.
+func (o *AuthConfig) newClientRequest() (xhttp.Clientx, http.Header, url.Values, error) {
+	if o.Client == nil {
+		return nil, nil, nil, errorsx.G11NError("The client details are not available for this session. Login again.")
+	}
+
+	return o.Client.newRequest(o.Tenant)
+}
+
+func newTokenInfo(claims map[string]any, source string) *TokenInfo {
+	info := &TokenInfo{
+		Subject:      claimString(claims, "sub"),
+		ClientID:     claimString(claims, "client_id", "cid", "azp"),
+		GrantType:    claimString(claims, "grant_type"),
+		IssuedAt:     claimInt(claims, "iat"),
+		ExpiresAt:    claimInt(claims, "exp"),
+		Scopes:       claimList(claims, "scope", "scp"),
+		Entitlements: claimList(claims, "entitlements"),
+		Source:       source,
+	}
+
+	// entitlements may be nested under the extension claims
+	if ext, ok := claims["ext"].(map[string]any); ok && len(info.Entitlements) == 0 {
+		info.Entitlements = claimList(ext, "entitlements")
+	}
+
+	return info
+}
+
+func claimString(claims map[string]any, names ...string) string {
+	for _, name := range names {
+		if v, ok := claims[name].(string); ok && len(v) > 0 {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func claimInt(claims map[string]any, name string) int64 {
+	if v, ok := claims[name].(float64); ok {
+		return int64(v)
+	}
+
+	return 0
+}
+
+// claimList reads claims that are either a space-delimited string or an array.
+func claimList(claims map[string]any, names ...string) []string {
+	for _, name := range names {
+		switch v := claims[name].(type) {
+		case string:
+			if len(v) > 0 {
+				return strings.Fields(v)
+			}
+		case []any:
+			list := []string{}
+			for _, item := range v {
+				if s, ok := item.(string); ok {
+					list = append(list, s)
+				}
+			}
+
+			return list
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+// keyringService is the service name under which credentials are saved.
+const keyringService = "verifyctl"
+
+// keyringStore saves credentials in the OS keyring. The Secret Service is used
+// on Linux through 'secret-tool' and the login keychain on macOS through 'security'.
+type keyringStore struct {
+	command string
+}
+
+func newKeyringStore() (CredentialStore, error) {
+	var command string
+	switch runtime.GOOS {
+	case "linux", "freebsd", "openbsd":
+		command = "secret-tool"
+	case "darwin":
+		command = "security"
+	default:
+		return nil, errorsx.G11NError("The keyring credential store is not supported on %s. Use the 'file' credential store instead.", runtime.GOOS)
+	}
+
+	if _, err := exec.LookPath(command); err != nil {
+		return nil, errorsx.G11NError("The keyring credential store requires '%s'; err=%v", command, err)
+	}
+
+	return &keyringStore{
+		command: command,
+	}, nil
+}
+
+func (s *keyringStore) Get(ref string) (*Credential, error) {
+	var args []string
+	if s.command == "security" {
+		args = []string{"find-generic-password", "-s", keyringService, "-a", ref, "-w"}
+	} else {
+		args = []string{"lookup", "service", keyringService, "account", ref}
+	}
+
+	out, err := s.run(nil, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := &Credential{}
+	if err := json.Unmarshal(bytes.TrimSpace(out), credential); err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+func (s *keyringStore) Set(ref string, credential *Credential) error {
+	data, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+
+	if s.command == "security" {
+		// run interactively so that the secret is not visible in the process list
+		cmd := fmt.Sprintf("add-generic-password -U -s %s -a %s -l %s -X %s\n", keyringService, ref, keyringService, hex.EncodeToString(data))
+		_, err = s.run([]byte(cmd), "-i")
+		return err
+	}
+
+	_, err = s.run(data, "store", "--label", keyringService+" "+ref, "service", keyringService, "account", ref)
+	return err
+}
+
+func (s *keyringStore) Delete(ref string) error {
+	var args []string
+	if s.command == "security" {
+		args = []string{"delete-generic-password", "-s", keyringService, "-a", ref}
+	} else {
+		args = []string{"clear", "service", keyringService, "account", ref}
+	}
+
+	_, err := s.run(nil, args...)
+	return err
+}
+
+func (s *keyringStore) run(stdin []byte, args ...string) ([]byte, error) {
+	cmd := exec.Command(s.command, args...)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); len(msg) > 0 {
+			return nil, fmt.Errorf("%s: %s", s.command, msg)
+		}
+
+		return nil, fmt.Errorf("%s: %v", s.command, err)
+	}
+
+	return out, nil
+}
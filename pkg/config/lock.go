@@ -0,0 +1,82 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	lockFileName = "config.lock"
+
+	// lockTimeout is how long to wait for another invocation to release the lock.
+	lockTimeout  = 30 * time.Second
+	lockInterval = 50 * time.Millisecond
+)
+
+// lockConfigDir takes an exclusive advisory lock on the config directory. The
+// returned function releases the lock.
+func lockConfigDir(configDir string) (func(), error) {
+	f, err := os.OpenFile(filepath.Join(configDir, lockFileName), os.O_CREATE|os.O_RDWR, securePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		err = tryLockFile(f)
+		if err == nil {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			_ = f.Close()
+			return nil, errorsx.G11NError("Timed out waiting for another verifyctl process to update the configuration; err=%v", err)
+		}
+
+		time.Sleep(lockInterval)
+	}
+
+	return func() {
+		_ = unlockFile(f)
+		_ = f.Close()
+	}, nil
+}
+
+// writeSecureFile replaces the file atomically so that readers never see a partial
+// write. The file can only be read by the current user.
+func writeSecureFile(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+
+	tempFile := f.Name()
+	defer func() {
+		// this is a no-op after the rename
+		_ = os.Remove(tempFile)
+	}()
+
+	if err := f.Chmod(securePerm); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile, path)
+}
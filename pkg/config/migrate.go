@@ -0,0 +1,304 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	cmdutil "github.com/ibm-verify/verifyctl/pkg/util/cmd"
+	"gopkg.in/yaml.v3"
+)
+
+// migration converts the config file from a version to the next one. It operates
+// on the raw document so that the structs only need to describe the current version.
+type migration struct {
+	from    string
+	to      string
+	migrate func(doc map[string]any) error
+}
+
+// migrations are applied in order until the document is at the current version.
+var migrations = []migration{
+	{from: "1.0", to: "2.0", migrate: migrateV1ToV2},
+}
+
+// secretKeys are redacted when the changes are displayed.
+var secretKeys = []string{"token", "refreshToken", "clientSecret", "key"}
+
+// Migration describes the changes made to the config file when it is upgraded
+// to the current version.
+type Migration struct {
+	// Path is the location of the config file.
+	Path string
+
+	// From is the version of the file and To is the current version. They are
+	// the same if the file does not need to be migrated.
+	From string
+	To   string
+
+	// Backup is the file where the contents are saved before they are migrated.
+	Backup string
+
+	// Before and After are the contents of the file with secrets redacted.
+	Before string
+	After  string
+}
+
+// Migrate reads the config file and returns the changes required to upgrade it to
+// the current version without modifying the file. The changes are saved with the
+// next update or by calling Update.
+func (o *CLIConfig) Migrate() (*Migration, error) {
+	configFile, err := configFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &Migration{
+		Path: configFile,
+		From: apiVersion,
+		To:   apiVersion,
+	}
+
+	data, err := os.ReadFile(configFile)
+	if os.IsNotExist(err) {
+		return ret, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	doc, err := parseDocument(data)
+	if err != nil || len(doc) == 0 {
+		return ret, err
+	}
+
+	if v, _ := doc["apiVersion"].(string); len(v) > 0 {
+		ret.From = v
+	} else {
+		ret.From = migrations[0].from
+	}
+
+	if ret.From != ret.To {
+		ret.Backup = backupFilePath(configFile, ret.From)
+	}
+
+	before, err := redactedYAML(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := migrateDocument(doc); err != nil {
+		return nil, err
+	}
+
+	after, err := redactedYAML(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.Before = before
+	ret.After = after
+	return ret, nil
+}
+
+// decode validates the kind and version of the config file and migrates it to
+// the current version, if needed.
+func (o *CLIConfig) decode(data []byte) error {
+	doc, err := parseDocument(data)
+	if err != nil || len(doc) == 0 {
+		return err
+	}
+
+	from, err := migrateDocument(doc)
+	if err != nil {
+		return err
+	}
+
+	b, err := yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	if err := yaml.Unmarshal(b, o); err != nil {
+		return err
+	}
+
+	// keep the original contents so that a backup is written with the migrated file
+	if from != apiVersion {
+		o.migratedFrom = from
+		o.original = data
+	}
+
+	return nil
+}
+
+func parseDocument(data []byte) (map[string]any, error) {
+	doc := map[string]any{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	// an empty file is treated as a new configuration
+	if len(doc) == 0 {
+		return doc, nil
+	}
+
+	if k, _ := doc["kind"].(string); k != kind {
+		return nil, errorsx.G11NError("The configuration file has an unsupported kind '%s'. Expected '%s'.", k, kind)
+	}
+
+	return doc, nil
+}
+
+// migrateDocument upgrades the document to the current version and returns
+// the version it was at.
+func migrateDocument(doc map[string]any) (string, error) {
+	version, _ := doc["apiVersion"].(string)
+	if len(version) == 0 {
+		// the version was not set by the earliest releases
+		version = migrations[0].from
+	}
+
+	from := version
+	if version != apiVersion && !slices.ContainsFunc(migrations, func(m migration) bool {
+		return m.from == version
+	}) {
+		return "", errorsx.G11NError("The configuration file has an unsupported version '%s'. It may have been created by a newer version of verifyctl.", version)
+	}
+
+	for _, m := range migrations {
+		if m.from != version {
+			continue
+		}
+
+		if err := m.migrate(doc); err != nil {
+			return "", errorsx.G11NError("Unable to migrate the configuration file from version '%s' to '%s'; err=%v", m.from, m.to, err)
+		}
+
+		version = m.to
+		doc["apiVersion"] = version
+	}
+
+	return from, nil
+}
+
+// migrateV1ToV2 names the auth entries and creates a context for each of them, so
+// that sessions are selected using contexts instead of the tenant.
+func migrateV1ToV2(doc map[string]any) error {
+	auths, _ := doc["auth"].([]any)
+	contexts, _ := doc["contexts"].([]any)
+
+	// contexts may have been added to the file by login already
+	names := map[string]bool{}
+	for _, c := range contexts {
+		if context, ok := c.(map[string]any); ok {
+			name, _ := context["name"].(string)
+			names[name] = true
+		}
+	}
+
+	currentTenant, _ := doc["tenant"].(string)
+	currentContext, _ := doc["currentContext"].(string)
+	for _, a := range auths {
+		auth, ok := a.(map[string]any)
+		if !ok {
+			return fmt.Errorf("invalid auth entry")
+		}
+
+		tenant, _ := auth["tenant"].(string)
+		name, _ := auth["name"].(string)
+		if len(name) == 0 {
+			name = tenant
+			auth["name"] = name
+		}
+
+		if !names[name] {
+			names[name] = true
+			contexts = append(contexts, map[string]any{
+				"name":   name,
+				"tenant": tenant,
+				"auth":   name,
+			})
+		}
+
+		if len(currentContext) == 0 && len(currentTenant) > 0 && tenant == currentTenant {
+			currentContext = name
+		}
+	}
+
+	if len(contexts) > 0 {
+		doc["contexts"] = contexts
+	}
+
+	if len(currentContext) > 0 {
+		doc["currentContext"] = currentContext
+	}
+
+	return nil
+}
+
+// writeBackup saves the contents of the file before it was migrated.
+func (o *CLIConfig) writeBackup(configDir string) error {
+	if len(o.migratedFrom) == 0 {
+		return nil
+	}
+
+	backupFile := backupFilePath(filepath.Join(configDir, fileName), o.migratedFrom)
+	if err := writeSecureFile(backupFile, o.original); err != nil {
+		return errorsx.G11NError("Unable to save a backup of the configuration file before it is migrated; err=%v", err)
+	}
+
+	o.migratedFrom = ""
+	o.original = nil
+	return nil
+}
+
+func backupFilePath(configFile string, version string) string {
+	return fmt.Sprintf("%s.v%s.bak", configFile, version)
+}
+
+func redactedYAML(doc map[string]any) (string, error) {
+	b, err := yaml.Marshal(redactDocument(doc))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// redactDocument returns a copy of the document without secrets.
+func redactDocument(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		ret := make(map[string]any, len(t))
+		for k, value := range t {
+			if s, ok := value.(string); ok && len(s) > 0 && slices.Contains(secretKeys, k) {
+				ret[k] = "REDACTED"
+				continue
+			}
+
+			ret[k] = redactDocument(value)
+		}
+
+		return ret
+	case []any:
+		ret := make([]any, 0, len(t))
+		for _, value := range t {
+			ret = append(ret, redactDocument(value))
+		}
+
+		return ret
+	}
+
+	return v
+}
+
+func configFilePath() (string, error) {
+	configDir, err := cmdutil.GetDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, fileName), nil
+}
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	oidc "github.com/ibm-verify/verify-sdk-go/pkg/auth"
+	contextx "github.com/ibm-verify/verify-sdk-go/pkg/core/context"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"github.com/ibm-verify/verifyctl/pkg/module"
+)
+
+const (
+	// renewBefore is how long before the expiry the token is renewed.
+	renewBefore = 60 * time.Second
+
+	// defaultTokenLifetime is assumed when the token response does not
+	// include 'expires_in'. It matches the default access token lifetime
+	// on Verify.
+	defaultTokenLifetime = 2 * time.Hour
+)
+
+// SetToken updates the token properties using the token response. The granted
+// scopes are read from the response or, if it does not include them and the token
+// is opaque, from the introspection endpoint of the tenant.
+func (o *AuthConfig) SetToken(ctx context.Context, tokenResponse *oidc.TokenResponse) {
+	o.Token = tokenResponse.AccessToken
+	o.GrantedScopes = strings.Fields(tokenResponse.Scope)
+	if len(o.GrantedScopes) == 0 && o.DecodeToken() == nil && o.Client != nil {
+		if info, err := o.Introspect(ctx); err != nil {
+			vc := contextx.GetVerifyContext(ctx)
+			vc.Logger.Debugf("unable to read the granted scopes; tenant=%s, err=%v", o.Tenant, err)
+		} else {
+			o.GrantedScopes = append(info.Entitlements, info.Scopes...)
+		}
+	}
+
+	if len(tokenResponse.RefreshToken) > 0 {
+		// the refresh token may not be rotated on renewal
+		o.RefreshToken = tokenResponse.RefreshToken
+	}
+
+	lifetime := time.Duration(tokenResponse.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+
+	o.ExpiresAt = time.Now().Add(lifetime).Unix()
+}
+
+// Renew gets a new token for the tenant. User sessions are renewed using the
+// refresh token, while API client sessions repeat the client credentials grant.
+// Sessions that use a credential plugin run the plugin again.
+func (o *AuthConfig) Renew(ctx context.Context) error {
+	if o.Exec != nil {
+		return o.runExec(ctx)
+	}
+
+	if o.Client == nil {
+		return errorsx.G11NError("Login again.")
+	}
+
+	var tokenResponse *oidc.TokenResponse
+	var err error
+	if o.User {
+		if len(o.RefreshToken) == 0 {
+			return errorsx.G11NError("Login again.")
+		}
+
+		tokenResponse, err = o.refresh(ctx)
+	} else {
+		// the client secret and private key are not saved with the plain store
+		if len(o.Client.ClientSecret) == 0 && len(o.Client.PrivateKey) == 0 {
+			return errorsx.G11NError("The client credentials for '%s' are not saved in the config file. Login again, or use the 'keyring' or 'file' credential store to renew the token automatically.", o.Name)
+		}
+
+		tokenResponse, err = o.Client.TokenWithAPIClient(ctx, o.Tenant)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	o.SetToken(ctx, tokenResponse)
+	return nil
+}
+
+// Revoke invalidates the access token and the refresh token, if any, using the
+// revocation endpoint of the tenant. The lifecycle of credentials provided by a
+// credential plugin is owned by the plugin, so only the cached token is removed
+// from the credential store.
+func (o *CLIConfig) Revoke(ctx context.Context, auth *AuthConfig) error {
+	if auth.Exec == nil {
+		return auth.revokeTokens(ctx)
+	}
+
+	store, err := NewCredentialStore(o.CredentialStoreName())
+	if err != nil {
+		return err
+	}
+
+	return auth.removeExecCache(store)
+}
+
+func (o *AuthConfig) revokeTokens(ctx context.Context) error {
+
+	if len(o.RefreshToken) > 0 {
+		if err := o.revoke(ctx, o.RefreshToken, "refresh_token"); err != nil {
+			return err
+		}
+	}
+
+	if len(o.Token) == 0 {
+		return nil
+	}
+
+	return o.revoke(ctx, o.Token, "access_token")
+}
+
+func (o *AuthConfig) isExpiring() bool {
+	if o.ExpiresAt == 0 {
+		// sessions created by older versions do not track the expiry
+		return false
+	}
+
+	return time.Now().Add(renewBefore).Unix() >= o.ExpiresAt
+}
+
+func (o *AuthConfig) refresh(ctx context.Context) (*oidc.TokenResponse, error) {
+	vc := contextx.GetVerifyContext(ctx)
+
+	client, headers, params, err := o.Client.newRequest(o.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	params.Set("grant_type", "refresh_token")
+	params.Set("refresh_token", o.RefreshToken)
+
+	u, _ := url.Parse(fmt.Sprintf("https://%s/oauth2/token", o.Tenant))
+	response, err := client.Post(ctx, u, headers, []byte(params.Encode()))
+	if err != nil {
+		vc.Logger.Errorf("unable to refresh the token; err=%s", err.Error())
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		if err := module.HandleCommonErrorsX(ctx, response, "unable to refresh the token"); err != nil {
+			vc.Logger.Errorf("unable to refresh the token; err=%s", err.Error())
+			return nil, err
+		}
+
+		return nil, errorsx.G11NError("unable to refresh the token")
+	}
+
+	tokenResponse := &oidc.TokenResponse{}
+	if err := json.Unmarshal(response.Body, tokenResponse); err != nil {
+		return nil, errorsx.G11NError("unable to refresh the token")
+	}
+
+	return tokenResponse, nil
+}
+
+func (o *AuthConfig) revoke(ctx context.Context, token string, hint string) error {
+	vc := contextx.GetVerifyContext(ctx)
+	client, headers, params, err := o.newClientRequest()
+	if err != nil {
+		return err
+	}
+
+	params.Set("token", token)
+	params.Set("token_type_hint", hint)
+
+	u, _ := url.Parse(fmt.Sprintf("https://%s/oauth2/revoke", o.Tenant))
+	response, err := client.Post(ctx, u, headers, []byte(params.Encode()))
+	if err != nil {
+		vc.Logger.Errorf("unable to revoke the token; err=%s", err.Error())
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		if err := module.HandleCommonErrorsX(ctx, response, "unable to revoke the token"); err != nil {
+			vc.Logger.Errorf("unable to revoke the token; err=%s", err.Error())
+			return err
+		}
+
+		return errorsx.G11NError("unable to revoke the token")
+	}
+
+	return nil
+}
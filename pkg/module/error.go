@@ -15,7 +15,7 @@ func HandleCommonErrorsX(ctx context.Context, response *xhttp.Response, defaultE
 	}
 
 	if response.StatusCode == http.StatusForbidden {
-		return errorsx.G11NError("You are not allowed to make this request. Check the client or application entitlements.")
+		return errorsx.G11NError("You are not allowed to make this request. Check the client or application entitlements using:\n  verifyctl auth status")
 	}
 
 	if response.StatusCode == http.StatusBadRequest {
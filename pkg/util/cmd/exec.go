@@ -15,6 +15,10 @@ import (
 const (
 	defaultDir  = ".verify"
 	defaultPerm = os.ModePerm
+
+	// configDirPerm restricts the config directory to the current user
+	// because it holds credentials.
+	configDirPerm = 0o700
 )
 
 func ExitOnError(cmd *cobra.Command, err error) {
@@ -55,7 +59,12 @@ func CreateOrGetDir() (string, error) {
 		return "", err
 	}
 
-	if err := os.MkdirAll(configDir, defaultPerm); err != nil {
+	if err := os.MkdirAll(configDir, configDirPerm); err != nil {
+		return "", err
+	}
+
+	// directories created by older versions are readable by other users
+	if err := os.Chmod(configDir, configDirPerm); err != nil {
 		return "", err
 	}
 
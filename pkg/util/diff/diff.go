@@ -0,0 +1,177 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around each change.
+const contextLines = 3
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// Unified returns the differences between the texts in the unified diff format.
+// It returns an empty string if they are the same.
+func Unified(fromName, toName, from, to string) string {
+	a := splitLines(from)
+	b := splitLines(to)
+	ops := lineOps(a, b)
+
+	changed := false
+	for _, o := range ops {
+		if o.kind != opEqual {
+			changed = true
+			break
+		}
+	}
+
+	if !changed {
+		return ""
+	}
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "--- %s\n+++ %s\n", fromName, toName)
+	for _, h := range hunks(ops) {
+		writeHunk(sb, ops, h)
+	}
+
+	return sb.String()
+}
+
+func splitLines(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// lineOps computes the edit script using the longest common subsequence.
+func lineOps(a, b []string) []op {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]op, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, op{opEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, op{opDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, op{opInsert, b[j]})
+			j++
+		}
+	}
+
+	for ; i < n; i++ {
+		ops = append(ops, op{opDelete, a[i]})
+	}
+
+	for ; j < m; j++ {
+		ops = append(ops, op{opInsert, b[j]})
+	}
+
+	return ops
+}
+
+// hunk is a range of the edit script.
+type hunk struct {
+	start, end int
+}
+
+// hunks groups the changes with their surrounding lines. Changes that are close
+// to each other are merged into the same hunk.
+func hunks(ops []op) []hunk {
+	var ret []hunk
+	for i, o := range ops {
+		if o.kind == opEqual {
+			continue
+		}
+
+		start := max(i-contextLines, 0)
+		end := min(i+contextLines+1, len(ops))
+		if len(ret) > 0 && start <= ret[len(ret)-1].end {
+			ret[len(ret)-1].end = end
+			continue
+		}
+
+		ret = append(ret, hunk{start, end})
+	}
+
+	return ret
+}
+
+func writeHunk(sb *strings.Builder, ops []op, h hunk) {
+	// line numbers of the hunk in both texts
+	fromLine, toLine := 1, 1
+	for _, o := range ops[:h.start] {
+		if o.kind != opInsert {
+			fromLine++
+		}
+
+		if o.kind != opDelete {
+			toLine++
+		}
+	}
+
+	fromCount, toCount := 0, 0
+	for _, o := range ops[h.start:h.end] {
+		if o.kind != opInsert {
+			fromCount++
+		}
+
+		if o.kind != opDelete {
+			toCount++
+		}
+	}
+
+	// an empty range starts at the line before it
+	if fromCount == 0 {
+		fromLine--
+	}
+
+	if toCount == 0 {
+		toLine--
+	}
+
+	fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", fromLine, fromCount, toLine, toCount)
+	for _, o := range ops[h.start:h.end] {
+		switch o.kind {
+		case opEqual:
+			sb.WriteString(" " + o.line + "\n")
+		case opDelete:
+			sb.WriteString("-" + o.line + "\n")
+		case opInsert:
+			sb.WriteString("+" + o.line + "\n")
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ibm-verify/verify-sdk-go/pkg/i18n"
+	"github.com/ibm-verify/verifyctl/pkg/util/secret"
+	"github.com/spf13/cobra"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+// Dry run modes
+const (
+	None   = "none"
+	Client = "client"
+	Server = "server"
+)
+
+// readOnlyPaths are the paths of the requests sent with POST that do not change the
+// tenant, such as searches and token requests. They are sent in a dry run.
+var readOnlyPaths = []string{
+	"/oauth2/token",
+	"/oauth2/introspect",
+	"/v2.0/Users/.search",
+	"/v2.0/Groups/.search",
+}
+
+type contextKey struct{}
+
+// AddFlag adds the '--dry-run' flag to the command. Using the flag without a value
+// is the same as '--dry-run=client'.
+func AddFlag(cmd *cobra.Command) {
+	cmd.Flags().String("dry-run", None, i18n.Translate("Preview the changes without making them. The values supported are 'none', 'client' and 'server'. With 'client', the requests that would change the tenant are printed instead of being sent. With 'server', the data is checked against the schema of the resource and the tenant is read to check that the resource exists, or does not exist when it is created. Verify does not offer validation-only endpoints, so the data is not checked by the tenant."))
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = Client
+}
+
+// Mode returns the dry run mode selected on the command.
+func Mode(cmd *cobra.Command) (string, error) {
+	flag := cmd.Flags().Lookup("dry-run")
+	if flag == nil {
+		return None, nil
+	}
+
+	switch mode := flag.Value.String(); mode {
+	case None, Client, Server:
+		return mode, nil
+	default:
+		return "", errorsx.G11NError("The value '%s' is not supported for 'dry-run'. The values supported are 'none', 'client' and 'server'.", mode)
+	}
+}
+
+// Suffix returns the text added to the messages of the mode, such as
+// "IBMVerifyUser 'jdoe' deleted (dry run)".
+func Suffix(mode string) string {
+	switch mode {
+	case Client:
+		return " (dry run)"
+	case Server:
+		return " (server dry run)"
+	}
+
+	return ""
+}
+
+// WithClient returns a context holding an HTTP client that writes the requests that
+// would change the tenant to w instead of sending them. Requests that only read, such
+// as looking up the ID of a resource, are still sent. The SDK clients use it if they
+// are created with the HTTP client returned by HTTPClient.
+func WithClient(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, contextKey{}, &http.Client{
+		Transport: &transport{
+			next: http.DefaultTransport,
+			w:    w,
+		},
+	})
+}
+
+// HTTPClient returns the HTTP client in the context, or nil if there is none, so that
+// the SDK uses its default client.
+func HTTPClient(ctx context.Context) *http.Client {
+	client, _ := ctx.Value(contextKey{}).(*http.Client)
+	return client
+}
+
+type transport struct {
+	next http.RoundTripper
+	w    io.Writer
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadOnly(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	body := []byte{}
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		body = b
+	}
+
+	if err := writeRequest(t.w, req, body); err != nil {
+		return nil, err
+	}
+
+	// the response is what the SDK expects when the request succeeds
+	status := http.StatusNoContent
+	header := http.Header{}
+	switch {
+	case req.Method == http.MethodPost:
+		status = http.StatusCreated
+		header.Set("Location", req.URL.JoinPath("dry-run").String())
+		header.Set("Content-Type", "application/json")
+	case req.Method == http.MethodPut && strings.Contains(req.URL.Path, "/policyvault/"):
+		// access policies are updated with 201
+		status = http.StatusCreated
+	}
+
+	respBody := []byte{}
+	if status == http.StatusCreated {
+		respBody = []byte("{}")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+func isReadOnly(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		return slices.Contains(readOnlyPaths, req.URL.Path)
+	}
+
+	return false
+}
+
+// writeRequest writes the method, URL, headers and body of the request. The token
+// and the secrets in the body are not written.
+func writeRequest(w io.Writer, req *http.Request, body []byte) error {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "%s %s\n", req.Method, req.URL.String())
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range req.Header.Values(name) {
+			if name == "Authorization" {
+				scheme, _, _ := strings.Cut(value, " ")
+				value = scheme + " [redacted]"
+			}
+
+			fmt.Fprintf(b, "%s: %s\n", name, value)
+		}
+	}
+
+	if len(body) > 0 {
+		b.WriteString("\n")
+		b.WriteString(formatBody(req.Header.Get("Content-Type"), body))
+	}
+
+	b.WriteString("\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatBody returns JSON indented and text as is. The values of the properties and
+// form fields that hold secrets, such as 'clientSecret' and 'password', are redacted.
+// The parts of multipart bodies are formatted in the same way, and binary content,
+// such as theme archives, is replaced by its size.
+func formatBody(contentType string, body []byte) string {
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	switch {
+	case strings.HasSuffix(mediaType, "json"):
+		if s, ok := formatJSON(body); ok {
+			return s
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for name := range form {
+				if secret.IsSecretKey(name) {
+					form[name] = []string{secret.Redacted}
+				}
+			}
+
+			return form.Encode() + "\n"
+		}
+	case strings.HasPrefix(mediaType, "multipart/"):
+		if s, ok := formatMultipart(params["boundary"], body); ok {
+			return s
+		}
+	}
+
+	if isText(mediaType, body) {
+		return strings.TrimSuffix(string(body), "\n") + "\n"
+	}
+
+	return i18n.TranslateWithArgs("[%d bytes]", len(body)) + "\n"
+}
+
+// formatJSON returns the JSON indented with the secrets redacted. The properties are
+// sorted by name.
+func formatJSON(body []byte) (string, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return "", false
+	}
+
+	out := &bytes.Buffer{}
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(secret.Redact(v)); err != nil {
+		return "", false
+	}
+
+	return out.String(), true
+}
+
+func formatMultipart(boundary string, body []byte) (string, bool) {
+	// the SDK does not always add the boundary to the content type, so it is read
+	// from the first line of the body
+	if len(boundary) == 0 {
+		line, _, _ := bytes.Cut(body, []byte("\n"))
+		boundary = strings.TrimPrefix(strings.TrimSpace(string(line)), "--")
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	b := &strings.Builder{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return b.String(), b.Len() > 0
+		} else if err != nil {
+			return "", false
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return "", false
+		}
+
+		fmt.Fprintf(b, "--%s\n", boundary)
+		fmt.Fprintf(b, "Content-Disposition: %s\n", part.Header.Get("Content-Disposition"))
+		if contentType := part.Header.Get("Content-Type"); len(contentType) > 0 {
+			fmt.Fprintf(b, "Content-Type: %s\n", contentType)
+		}
+
+		b.WriteString("\n")
+		if len(part.FileName()) > 0 {
+			b.WriteString(formatBody(part.Header.Get("Content-Type"), content))
+		} else {
+			b.WriteString(formatBody("text/plain", content))
+		}
+	}
+}
+
+func isText(mediaType string, body []byte) bool {
+	if strings.HasPrefix(mediaType, "text/") || mediaType == "application/x-www-form-urlencoded" {
+		return true
+	}
+
+	return len(mediaType) == 0 && json.Valid(body)
+}
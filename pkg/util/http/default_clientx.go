@@ -30,6 +30,23 @@ func NewDefaultClient() Clientx {
 	}
 }
 
+// NewClient returns a client with the default settings that uses the HTTP client,
+// for example, to present a TLS client certificate.
+func NewClient(client *http.Client) Clientx {
+	return &defaultClientx{
+		client: client,
+	}
+}
+
+// NewHTTPClient returns an HTTP client with the default settings using the transport.
+func NewHTTPClient(transport http.RoundTripper) *http.Client {
+	return &http.Client{
+		Transport:     transport,
+		Timeout:       defaultClient.Timeout,
+		CheckRedirect: noRedirects,
+	}
+}
+
 // Get makes a HTTP GET call and returns the response
 func (c *defaultClientx) Get(ctx context.Context, url *url.URL, headers http.Header) (*Response, error) {
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
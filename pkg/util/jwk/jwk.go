@@ -0,0 +1,300 @@
+package jwk
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+
+	"github.com/go-jose/go-jose/v4"
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+const (
+	KeyTypeRSA = "rsa"
+	KeyTypeEC  = "ec"
+
+	DefaultRSASize = 2048
+)
+
+// curves maps the curve names to the curves supported for EC keys.
+var curves = map[string]elliptic.Curve{
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
+
+// Generate creates a private key. The size is the number of bits for RSA keys
+// and is ignored for EC keys, which use the curve.
+func Generate(keyType string, size int, curve string) (*jose.JSONWebKey, error) {
+	var key crypto.Signer
+	var err error
+	switch strings.ToLower(keyType) {
+	case KeyTypeRSA:
+		if size == 0 {
+			size = DefaultRSASize
+		}
+
+		if size < DefaultRSASize {
+			return nil, errorsx.G11NError("RSA keys must be at least %d bits.", DefaultRSASize)
+		}
+
+		key, err = rsa.GenerateKey(rand.Reader, size)
+	case KeyTypeEC:
+		if len(curve) == 0 {
+			curve = "P-256"
+		}
+
+		c, ok := curves[curve]
+		if !ok {
+			return nil, errorsx.G11NError("Unsupported curve '%s'. Use 'P-256', 'P-384' or 'P-521'.", curve)
+		}
+
+		key, err = ecdsa.GenerateKey(c, rand.Reader)
+	default:
+		return nil, errorsx.G11NError("Unsupported key type '%s'. Use '%s' or '%s'.", keyType, KeyTypeRSA, KeyTypeEC)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newJSONWebKey(key, nil)
+}
+
+// Parse reads a private key in JWK format or a PEM encoded PKCS#1, PKCS#8 or SEC 1
+// private key. The certificate is optional and may also be included in the key PEM.
+//
+// The key ID and algorithm are set if they are not in the key. The key ID is the
+// SHA-1 thumbprint of the certificate, if provided, or the JWK thumbprint.
+func Parse(key string, certificate string) (*jose.JSONWebKey, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "{") {
+		jwk := &jose.JSONWebKey{}
+		if err := json.Unmarshal([]byte(key), jwk); err != nil {
+			return nil, err
+		}
+
+		if jwk.IsPublic() {
+			return nil, errorsx.G11NError("The key is a public key. A private key is required.")
+		}
+
+		certs, err := parseCertificates(certificate)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(certs) > 0 {
+			if err := setCertificates(jwk, certs); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := setDefaults(jwk); err != nil {
+			return nil, err
+		}
+
+		return jwk, nil
+	}
+
+	var signer crypto.Signer
+	certs, err := parseCertificates(certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	rest := []byte(key)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+
+			certs = append(certs, cert)
+		case "RSA PRIVATE KEY":
+			signer, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			signer, err = x509.ParseECPrivateKey(block.Bytes)
+		case "PRIVATE KEY":
+			var k any
+			k, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err == nil {
+				var ok bool
+				if signer, ok = k.(crypto.Signer); !ok {
+					return nil, errorsx.G11NError("Unsupported private key type.")
+				}
+			}
+		case "ENCRYPTED PRIVATE KEY":
+			return nil, errorsx.G11NError("Encrypted private keys are not supported. Decrypt the key first.")
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if signer == nil {
+		return nil, errorsx.G11NError("The key is not a valid JWK or PEM encoded private key.")
+	}
+
+	return newJSONWebKey(signer, certs)
+}
+
+// Public returns the public key set used to register the key on the client.
+func Public(jwk *jose.JSONWebKey) *jose.JSONWebKeySet {
+	return &jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{jwk.Public()},
+	}
+}
+
+// PublicPEM returns the public key in PEM format.
+func PublicPEM(jwk *jose.JSONWebKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(jwk.Public().Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: der,
+	}), nil
+}
+
+func newJSONWebKey(key crypto.Signer, certs []*x509.Certificate) (*jose.JSONWebKey, error) {
+	jwk := &jose.JSONWebKey{
+		Key: key,
+		Use: "sig",
+	}
+
+	if len(certs) > 0 {
+		if err := setCertificates(jwk, certs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := setDefaults(jwk); err != nil {
+		return nil, err
+	}
+
+	return jwk, nil
+}
+
+func setCertificates(jwk *jose.JSONWebKey, certs []*x509.Certificate) error {
+	// the leaf certificate must match the key
+	public := jwk.Public()
+	if !public.Valid() {
+		return errorsx.G11NError("The key is not valid.")
+	}
+
+	expected, err := x509.MarshalPKIXPublicKey(public.Key)
+	if err != nil {
+		return err
+	}
+
+	actual, err := x509.MarshalPKIXPublicKey(certs[0].PublicKey)
+	if err != nil {
+		return err
+	}
+
+	if string(expected) != string(actual) {
+		return errorsx.G11NError("The certificate does not match the private key.")
+	}
+
+	sha1Sum := sha1.Sum(certs[0].Raw)
+	sha256Sum := sha256.Sum256(certs[0].Raw)
+	jwk.Certificates = certs
+	jwk.CertificateThumbprintSHA1 = sha1Sum[:]
+	jwk.CertificateThumbprintSHA256 = sha256Sum[:]
+	return nil
+}
+
+func setDefaults(jwk *jose.JSONWebKey) error {
+	if len(jwk.Algorithm) == 0 {
+		alg, err := algorithm(jwk.Key)
+		if err != nil {
+			return err
+		}
+
+		jwk.Algorithm = alg
+	}
+
+	if len(jwk.KeyID) > 0 {
+		return nil
+	}
+
+	if len(jwk.CertificateThumbprintSHA1) > 0 {
+		jwk.KeyID = base64.RawURLEncoding.EncodeToString(jwk.CertificateThumbprintSHA1)
+		return nil
+	}
+
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return err
+	}
+
+	jwk.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	return nil
+}
+
+func algorithm(key any) (string, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return string(jose.RS256), nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve.Params().Name {
+		case "P-256":
+			return string(jose.ES256), nil
+		case "P-384":
+			return string(jose.ES384), nil
+		case "P-521":
+			return string(jose.ES512), nil
+		}
+	}
+
+	return "", errorsx.G11NError("Unsupported private key type.")
+}
+
+func parseCertificates(certificate string) ([]*x509.Certificate, error) {
+	certs := []*x509.Certificate{}
+	rest := []byte(strings.TrimSpace(certificate))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certificate) > 0 && len(certs) == 0 {
+		return nil, errorsx.G11NError("The certificate is not a valid PEM encoded X.509 certificate.")
+	}
+
+	return certs, nil
+}
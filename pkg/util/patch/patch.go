@@ -0,0 +1,332 @@
+package patch
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+// Operation is an operation of a JSON patch, as defined in RFC 6902.
+type Operation struct {
+	// Op is the operation: 'add', 'remove', 'replace', 'move', 'copy' or 'test'.
+	Op string `json:"op" yaml:"op"`
+
+	// Path is the JSON pointer to the value the operation changes or tests.
+	Path string `json:"path" yaml:"path"`
+
+	// From is the JSON pointer to the value that is moved or copied.
+	From string `json:"from,omitempty" yaml:"from,omitempty"`
+
+	// Value is the value that is added or tested.
+	Value interface{} `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// Merge applies a JSON merge patch, as defined in RFC 7396, to the document and
+// returns the result. Properties set to null in the patch are removed, objects are
+// merged recursively and other values replace the ones in the document.
+//
+// The document and patch are expected to hold the types produced by
+// 'encoding/json'. The document is changed in place.
+func Merge(doc interface{}, patch interface{}) interface{} {
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return copyValue(patch)
+	}
+
+	docMap, ok := doc.(map[string]interface{})
+	if !ok {
+		docMap = map[string]interface{}{}
+	}
+
+	for k, v := range patchMap {
+		if v == nil {
+			delete(docMap, k)
+			continue
+		}
+
+		docMap[k] = Merge(docMap[k], v)
+	}
+
+	return docMap
+}
+
+// Apply applies the operations of a JSON patch, as defined in RFC 6902, to the
+// document and returns the result. The operations are applied in order and the
+// patch fails as a whole if any of them fail.
+//
+// The document and values are expected to hold the types produced by
+// 'encoding/json'. The operations are applied to a copy, so the document is not
+// changed.
+func Apply(doc interface{}, operations []Operation) (interface{}, error) {
+	var err error
+	doc = copyValue(doc)
+	for i, op := range operations {
+		if doc, err = apply(doc, op); err != nil {
+			return nil, errorsx.G11NError("The operation %d ('%s' at '%s') failed; err=%v", i+1, op.Op, op.Path, err)
+		}
+	}
+
+	return doc, nil
+}
+
+func apply(doc interface{}, op Operation) (interface{}, error) {
+	switch op.Op {
+	case "add":
+		return add(doc, op.Path, copyValue(op.Value))
+	case "remove":
+		doc, _, err := remove(doc, op.Path)
+		return doc, err
+	case "replace":
+		doc, _, err := remove(doc, op.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		return add(doc, op.Path, copyValue(op.Value))
+	case "move":
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, errorsx.G11NError("A value cannot be moved into itself.")
+		}
+
+		doc, value, err := remove(doc, op.From)
+		if err != nil {
+			return nil, err
+		}
+
+		return add(doc, op.Path, value)
+	case "copy":
+		value, err := get(doc, op.From)
+		if err != nil {
+			return nil, err
+		}
+
+		return add(doc, op.Path, copyValue(value))
+	case "test":
+		value, err := get(doc, op.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !reflect.DeepEqual(normalize(value), normalize(op.Value)) {
+			return nil, errorsx.G11NError("The value does not match.")
+		}
+
+		return doc, nil
+	}
+
+	return nil, errorsx.G11NError("The operation '%s' is not supported.", op.Op)
+}
+
+// parsePointer returns the reference tokens of a JSON pointer, as defined in RFC 6901.
+func parsePointer(pointer string) ([]string, error) {
+	if len(pointer) == 0 {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, errorsx.G11NError("The path '%s' must start with '/'.", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+func get(doc interface{}, pointer string) (interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		switch v := doc.(type) {
+		case map[string]interface{}:
+			value, ok := v[token]
+			if !ok {
+				return nil, errorsx.G11NError("The path '%s' does not exist.", pointer)
+			}
+
+			doc = value
+		case []interface{}:
+			i, err := index(token, len(v)-1)
+			if err != nil {
+				return nil, err
+			}
+
+			doc = v[i]
+		default:
+			return nil, errorsx.G11NError("The path '%s' does not exist.", pointer)
+		}
+	}
+
+	return doc, nil
+}
+
+// add sets the value at the path. The parent of the value must exist.
+func add(doc interface{}, pointer string, value interface{}) (interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	return update(doc, pointer, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch v := parent.(type) {
+		case map[string]interface{}:
+			v[token] = value
+			return v, nil
+		case []interface{}:
+			if token == "-" {
+				return append(v, value), nil
+			}
+
+			i, err := index(token, len(v))
+			if err != nil {
+				return nil, err
+			}
+
+			v = append(v, nil)
+			copy(v[i+1:], v[i:])
+			v[i] = value
+			return v, nil
+		}
+
+		return nil, errorsx.G11NError("The path '%s' does not exist.", pointer)
+	})
+}
+
+// remove deletes the value at the path and returns it.
+func remove(doc interface{}, pointer string) (interface{}, interface{}, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, doc, nil
+	}
+
+	var removed interface{}
+	doc, err = update(doc, pointer, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch v := parent.(type) {
+		case map[string]interface{}:
+			value, ok := v[token]
+			if !ok {
+				return nil, errorsx.G11NError("The path '%s' does not exist.", pointer)
+			}
+
+			removed = value
+			delete(v, token)
+			return v, nil
+		case []interface{}:
+			i, err := index(token, len(v)-1)
+			if err != nil {
+				return nil, err
+			}
+
+			removed = v[i]
+			return append(v[:i], v[i+1:]...), nil
+		}
+
+		return nil, errorsx.G11NError("The path '%s' does not exist.", pointer)
+	})
+
+	return doc, removed, err
+}
+
+// update finds the parent of the last token and replaces it with the result of the
+// change, since arrays may be reallocated.
+func update(doc interface{}, pointer string, tokens []string, change func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(tokens) == 1 {
+		return change(doc, tokens[0])
+	}
+
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		child, ok := v[tokens[0]]
+		if !ok {
+			return nil, errorsx.G11NError("The path '%s' does not exist.", pointer)
+		}
+
+		child, err := update(child, pointer, tokens[1:], change)
+		if err != nil {
+			return nil, err
+		}
+
+		v[tokens[0]] = child
+		return v, nil
+	case []interface{}:
+		i, err := index(tokens[0], len(v)-1)
+		if err != nil {
+			return nil, err
+		}
+
+		child, err := update(v[i], pointer, tokens[1:], change)
+		if err != nil {
+			return nil, err
+		}
+
+		v[i] = child
+		return v, nil
+	}
+
+	return nil, errorsx.G11NError("The path '%s' does not exist.", pointer)
+}
+
+// index parses the array index in the token, which must be between 0 and max.
+func index(token string, max int) (int, error) {
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i > max || (len(token) > 1 && token[0] == '0') {
+		return 0, errorsx.G11NError("The array index '%s' is not valid.", token)
+	}
+
+	return i, nil
+}
+
+// copyValue returns a deep copy of the value, so that the same value is not shared
+// by several places in the document.
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[k] = copyValue(item)
+		}
+
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, item := range v {
+			a[i] = copyValue(item)
+		}
+
+		return a
+	}
+
+	return value
+}
+
+// normalize converts the value to the types produced by 'encoding/json', so that
+// numbers compare equal regardless of how they were decoded.
+func normalize(value interface{}) interface{} {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return value
+	}
+
+	return v
+}
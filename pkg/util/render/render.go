@@ -0,0 +1,112 @@
+package render
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"text/template"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Values are the values used to render the templates. They are available in the
+// templates as '.Values' and the environment variables as '.Env'.
+type Values map[string]interface{}
+
+// LoadFile merges the values in the YAML file into the values. Maps are merged
+// recursively and other values in the file replace the existing ones.
+func (v Values) LoadFile(file string) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return errorsx.G11NError("Unable to read the values in '%s'; err=%v", file, err)
+	}
+
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return errorsx.G11NError("Unable to read the values in '%s'; err=%v", file, err)
+	}
+
+	merge(v, values)
+	return nil
+}
+
+// Set sets the value of an expression in the form 'key=value'. The key can refer
+// to a nested value using dots, such as 'app.redirectUri=https://...'. The value
+// is always a string.
+func (v Values) Set(expr string) error {
+	key, value, ok := strings.Cut(expr, "=")
+	if !ok || len(key) == 0 {
+		return errorsx.G11NError("The value '%s' must be in the form 'key=value'.", expr)
+	}
+
+	parts := strings.Split(key, ".")
+	m := map[string]interface{}(v)
+	for _, part := range parts[:len(parts)-1] {
+		if len(part) == 0 {
+			return errorsx.G11NError("The key '%s' is not valid.", key)
+		}
+
+		child, ok := m[part].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			m[part] = child
+		}
+
+		m = child
+	}
+
+	last := parts[len(parts)-1]
+	if len(last) == 0 {
+		return errorsx.G11NError("The key '%s' is not valid.", key)
+	}
+
+	m[last] = value
+	return nil
+}
+
+// Render executes the text as a Go template. Referring to a value or environment
+// variable that is not defined is an error, so that a resource is not created
+// with missing values.
+func Render(name string, text []byte, values Values) ([]byte, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(string(text))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"Values": map[string]interface{}(values),
+		"Env":    environ(),
+	}
+
+	b := &bytes.Buffer{}
+	if err := t.Execute(b, data); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func environ() map[string]string {
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			env[k] = v
+		}
+	}
+
+	return env
+}
+
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, srcOk := v.(map[string]interface{})
+		dstMap, dstOk := dst[k].(map[string]interface{})
+		if srcOk && dstOk {
+			merge(dstMap, srcMap)
+			continue
+		}
+
+		dst[k] = v
+	}
+}
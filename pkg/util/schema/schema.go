@@ -0,0 +1,401 @@
+package schema
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Types of the values, as defined by JSON Schema
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+)
+
+// Schema describes a JSON value using a subset of the JSON Schema vocabulary.
+type Schema struct {
+	// Type is the type of the value. It is empty if any value is accepted.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+
+	// Properties are the properties of an object. If it is nil, the object can have
+	// any property that matches AdditionalProperties.
+	Properties map[string]*Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
+
+	// AdditionalProperties describes the values of an object that has no fixed
+	// properties, such as a map.
+	AdditionalProperties *Schema `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+
+	// Items describes the items of an array.
+	Items *Schema `json:"items,omitempty" yaml:"items,omitempty"`
+
+	// Required are the properties of an object that must be set.
+	Required []string `json:"required,omitempty" yaml:"required,omitempty"`
+
+	// Description describes the value.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Enum are the values allowed, if they are known.
+	Enum []string `json:"enum,omitempty" yaml:"enum,omitempty"`
+
+	// GoType is the name of the Go type the schema was generated from.
+	GoType string `json:"-" yaml:"-"`
+}
+
+// TypeDoc is the documentation of a Go type, which is not available using reflection.
+// It is generated from the source of the type, or from the API specification of the
+// SDK for the types whose source has no comments.
+type TypeDoc struct {
+	// Description is the comment of the type.
+	Description string
+
+	// Fields are the comments of the fields of a struct, keyed by their JSON names.
+	Fields map[string]string
+
+	// Enum are the values of the constants of the type.
+	Enum []string
+
+	// FieldEnums are the values allowed for the string fields of a struct, keyed by
+	// their JSON names.
+	FieldEnums map[string][]string
+}
+
+// TypeKey returns the key of the type in the documentation, which is the path of
+// its package and its name. It returns an empty string if the type has no name.
+func TypeKey(t reflect.Type) string {
+	if len(t.Name()) == 0 || len(t.PkgPath()) == 0 {
+		return ""
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	rawMessageType      = reflect.TypeOf(json.RawMessage{})
+	timeType            = reflect.TypeOf(time.Time{})
+)
+
+// FromType returns the schema of the values that 'encoding/json' can decode into
+// the type. Properties are named after the 'json' tags of the fields. Types that
+// decode themselves, other than times, accept any value.
+//
+// The descriptions and allowed values are read from the documentation, keyed by
+// TypeKey. It can be nil.
+func FromType(t reflect.Type, docs map[string]*TypeDoc) *Schema {
+	g := &generator{
+		docs:     docs,
+		visiting: map[reflect.Type]bool{},
+	}
+
+	return g.fromType(t)
+}
+
+type generator struct {
+	docs map[string]*TypeDoc
+
+	// visiting holds the structs being generated, to detect recursive types.
+	visiting map[reflect.Type]bool
+}
+
+func (g *generator) fromType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	s := &Schema{
+		GoType: t.String(),
+	}
+
+	doc := g.docs[TypeKey(t)]
+	if doc != nil {
+		s.Description = doc.Description
+		s.Enum = doc.Enum
+	}
+
+	switch {
+	case t == timeType:
+		s.Type = TypeString
+		return s
+	case t == rawMessageType, reflect.PointerTo(t).Implements(jsonUnmarshalerType):
+		return s
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		s.Type = TypeString
+		return s
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		s.Type = TypeString
+	case reflect.Bool:
+		s.Type = TypeBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s.Type = TypeInteger
+	case reflect.Float32, reflect.Float64:
+		s.Type = TypeNumber
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// bytes are encoded as base64 strings
+			s.Type = TypeString
+			break
+		}
+
+		s.Type = TypeArray
+		s.Items = g.fromType(t.Elem())
+	case reflect.Map:
+		s.Type = TypeObject
+		s.AdditionalProperties = g.fromType(t.Elem())
+	case reflect.Struct:
+		// recursive types accept any value where they refer to themselves
+		if g.visiting[t] {
+			s.Type = TypeObject
+			s.AdditionalProperties = &Schema{}
+			break
+		}
+
+		g.visiting[t] = true
+		defer delete(g.visiting, t)
+
+		s.Type = TypeObject
+		s.Properties = map[string]*Schema{}
+		g.addFields(s, t)
+	}
+
+	return s
+}
+
+// addFields adds the fields of the struct to the properties, including the ones of
+// embedded structs, in the same way as 'encoding/json'.
+func (g *generator) addFields(s *Schema, t reflect.Type) {
+	var fieldDocs map[string]string
+	var fieldEnums map[string][]string
+	if doc := g.docs[TypeKey(t)]; doc != nil {
+		fieldDocs = doc.Fields
+		fieldEnums = doc.FieldEnums
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && len(name) == 0 {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				g.addFields(s, ft)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		p := g.fromType(field.Type)
+		if len(fieldDocs[name]) > 0 {
+			p.Description = fieldDocs[name]
+		}
+
+		if len(fieldEnums[name]) > 0 {
+			p.Enum = fieldEnums[name]
+		}
+
+		s.Properties[name] = p
+	}
+}
+
+// PropertyNames returns the names of the properties sorted by name.
+func (s *Schema) PropertyNames() []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Property returns the name and schema of the property. Names are matched ignoring
+// case if there is no exact match, in the same way as 'encoding/json'. It returns a
+// nil schema if the object cannot have the property.
+func (s *Schema) Property(name string) (string, *Schema) {
+	if p, ok := s.Properties[name]; ok {
+		return name, p
+	}
+
+	for n, p := range s.Properties {
+		if strings.EqualFold(n, name) {
+			return n, p
+		}
+	}
+
+	return name, s.AdditionalProperties
+}
+
+// Error is a value that does not match the schema.
+type Error struct {
+	// Line and Column are the position of the value in the file, starting at 1.
+	Line   int
+	Column int
+
+	// Path is the path to the value, such as 'data.providers.oidc'.
+	Path string
+
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d:%d: %s: %s", e.Line, e.Column, e.Path, e.Message)
+}
+
+// Validate checks the YAML node against the schema and returns the values that do
+// not match. Null values are accepted for any type, since they are not set when
+// the data is decoded.
+func Validate(node *yaml.Node, s *Schema, path string) []*Error {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	if node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return nil
+		}
+
+		return Validate(node.Content[0], s, path)
+	}
+
+	if len(s.Type) == 0 || (node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null") {
+		return nil
+	}
+
+	errs := []*Error{}
+	typeError := func() []*Error {
+		return append(errs, &Error{
+			Line:    node.Line,
+			Column:  node.Column,
+			Path:    path,
+			Message: fmt.Sprintf("expected %s, but found %s", article(s.Type), article(nodeType(node))),
+		})
+	}
+
+	switch s.Type {
+	case TypeObject:
+		if node.Kind != yaml.MappingNode {
+			return typeError()
+		}
+
+		found := map[string]bool{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "<<" {
+				// merge keys are expanded by the decoder
+				errs = append(errs, Validate(value, s, path)...)
+				continue
+			}
+
+			name, child := s.Property(key.Value)
+			found[name] = true
+
+			if child == nil {
+				errs = append(errs, &Error{
+					Line:    key.Line,
+					Column:  key.Column,
+					Path:    join(path, key.Value),
+					Message: "unknown property",
+				})
+				continue
+			}
+
+			errs = append(errs, Validate(value, child, join(path, key.Value))...)
+		}
+
+		for _, name := range s.Required {
+			if !found[name] {
+				errs = append(errs, &Error{
+					Line:    node.Line,
+					Column:  node.Column,
+					Path:    path,
+					Message: fmt.Sprintf("missing required property '%s'", name),
+				})
+			}
+		}
+	case TypeArray:
+		if node.Kind != yaml.SequenceNode {
+			return typeError()
+		}
+
+		for i, item := range node.Content {
+			errs = append(errs, Validate(item, s.Items, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	default:
+		t := nodeType(node)
+		if t != s.Type && (s.Type != TypeNumber || t != TypeInteger) {
+			return typeError()
+		}
+	}
+
+	return errs
+}
+
+// nodeType returns the JSON Schema type of the value.
+func nodeType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return TypeObject
+	case yaml.SequenceNode:
+		return TypeArray
+	}
+
+	switch node.ShortTag() {
+	case "!!int":
+		return TypeInteger
+	case "!!float":
+		return TypeNumber
+	case "!!bool":
+		return TypeBoolean
+	case "!!null":
+		return "null"
+	}
+
+	return TypeString
+}
+
+func article(t string) string {
+	switch t {
+	case TypeObject, TypeArray, TypeInteger:
+		return "an " + t
+	case "null":
+		return t
+	}
+
+	return "a " + t
+}
+
+func join(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,162 @@
+package secret
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"strings"
+
+	errorsx "github.com/ibm-verify/verify-sdk-go/pkg/core/errors"
+)
+
+// Prefixes of the secret references
+const (
+	PrefixEnv  = "env:"
+	PrefixFile = "file:"
+	PrefixExec = "exec:"
+)
+
+// secretKeys are the properties that hold secrets in resource files. They are
+// matched ignoring case.
+var secretKeys = map[string]bool{
+	"clientsecret":  true,
+	"client_secret": true,
+	"password":      true,
+	"secret":        true,
+}
+
+// IsSecretKey returns true if the property holds a secret.
+func IsSecretKey(key string) bool {
+	return secretKeys[strings.ToLower(key)]
+}
+
+// Resolve returns the secret that the value refers to. The references supported are:
+//
+//   - 'env:NAME' reads the environment variable
+//   - 'file:/path' reads the file, without the trailing line break
+//   - 'exec:command args' runs the command and reads its output, without the
+//     trailing line break. The arguments are separated by spaces and are not
+//     interpreted by a shell.
+//
+// Other values are returned as is.
+func Resolve(value string) (string, error) {
+	if name, ok := strings.CutPrefix(value, PrefixEnv); ok {
+		v, found := os.LookupEnv(name)
+		if !found {
+			return "", errorsx.G11NError("The environment variable '%s' is not set.", name)
+		}
+
+		return v, nil
+	}
+
+	if path, ok := strings.CutPrefix(value, PrefixFile); ok {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", errorsx.G11NError("Unable to read the secret from '%s'; err=%v", path, err)
+		}
+
+		return trimLineBreak(string(b)), nil
+	}
+
+	if command, ok := strings.CutPrefix(value, PrefixExec); ok {
+		args := strings.Fields(command)
+		if len(args) == 0 {
+			return "", errorsx.G11NError("The command is missing in the secret reference '%s'.", value)
+		}
+
+		stdout := &bytes.Buffer{}
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdout = stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return "", errorsx.G11NError("Unable to read the secret using '%s'; err=%v", args[0], err)
+		}
+
+		return trimLineBreak(stdout.String()), nil
+	}
+
+	return value, nil
+}
+
+// ResolveData resolves the secret references in the properties of the data that
+// hold secrets, such as 'clientSecret' and 'password', and in the 'value' of
+// properties marked as 'sensitive'. Maps and lists are updated in place.
+func ResolveData(data any) (bool, error) {
+	changed := false
+	switch v := data.(type) {
+	case map[string]any:
+		sensitive, _ := v["sensitive"].(bool)
+		for key, value := range v {
+			if s, ok := value.(string); ok {
+				if !IsSecretKey(key) && !(sensitive && key == "value") {
+					continue
+				}
+
+				resolved, err := Resolve(s)
+				if err != nil {
+					return false, errorsx.G11NError("Unable to resolve '%s'; err=%v", key, err)
+				}
+
+				changed = changed || resolved != s
+				v[key] = resolved
+				continue
+			}
+
+			c, err := ResolveData(value)
+			if err != nil {
+				return false, err
+			}
+
+			changed = changed || c
+		}
+	case []any:
+		for _, value := range v {
+			c, err := ResolveData(value)
+			if err != nil {
+				return false, err
+			}
+
+			changed = changed || c
+		}
+	}
+
+	return changed, nil
+}
+
+// Redacted replaces the secrets in the data returned by Redact.
+const Redacted = "REDACTED"
+
+// Redact returns a copy of the data with the values of the properties that hold
+// secrets, identified in the same way as ResolveData, replaced by Redacted, so that
+// the data can be printed.
+func Redact(data any) any {
+	switch v := data.(type) {
+	case map[string]any:
+		sensitive, _ := v["sensitive"].(bool)
+		ret := make(map[string]any, len(v))
+		for key, value := range v {
+			if s, ok := value.(string); ok && len(s) > 0 && (IsSecretKey(key) || (sensitive && key == "value")) {
+				ret[key] = Redacted
+				continue
+			}
+
+			ret[key] = Redact(value)
+		}
+
+		return ret
+	case []any:
+		ret := make([]any, 0, len(v))
+		for _, value := range v {
+			ret = append(ret, Redact(value))
+		}
+
+		return ret
+	}
+
+	return data
+}
+
+func trimLineBreak(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return strings.TrimSuffix(s, "\r")
+}